@@ -3,22 +3,34 @@ package handlers
 import (
 	"Vectory/db"
 	collectionent "Vectory/entities/collection"
+	"Vectory/entities/index"
+	"Vectory/entities/mappings"
 	"Vectory/gen/api/models"
 	"Vectory/gen/api/restapi/operations"
 	"Vectory/gen/api/restapi/operations/collection"
 	"errors"
+	"fmt"
 	"github.com/go-openapi/runtime/middleware"
 	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
 )
 
 type CollectionHandler struct {
 	db *db.DB
+
+	// backupsPath is the directory backups are written to and restored from
+	backupsPath string
 }
 
 func (h *CollectionHandler) initHandlers(api *operations.VectoryAPI) {
 	api.CollectionGetCollectionHandler = collection.GetCollectionHandlerFunc(h.getCollection)
 	api.CollectionAddCollectionHandler = collection.AddCollectionHandlerFunc(h.addCollection)
 	api.CollectionDeleteCollectionHandler = collection.DeleteCollectionHandlerFunc(h.deleteCollection)
+	api.CollectionBackupCollectionHandler = collection.BackupCollectionHandlerFunc(h.backupCollection)
+	api.CollectionRestoreCollectionHandler = collection.RestoreCollectionHandlerFunc(h.restoreCollection)
 }
 
 // getCollection handler for getting collection configuration
@@ -27,12 +39,7 @@ func (h *CollectionHandler) getCollection(params collection.GetCollectionParams)
 
 	c, err := h.db.GetCollection(ctx, params.CollectionName)
 	if err != nil {
-		code := http.StatusInternalServerError
-		if errors.Is(err, db.ErrValidationFailed) {
-			code = http.StatusBadRequest
-		}
-
-		return middleware.Error(code, handleError(err))
+		return middleware.Error(errorCode(err), handleError(err))
 	}
 
 	cfg, err := c.GetConfig()
@@ -47,6 +54,26 @@ func (h *CollectionHandler) getCollection(params collection.GetCollectionParams)
 		IndexParams:    cfg.IndexParams,
 		EmbedderConfig: cfg.EmbedderConfig,
 		DataType:       cfg.DataType,
+		VectorType:     cfg.VectorType,
+		Mappings:       make([]*models.Mapping, 0, len(cfg.Mappings)),
+	}
+
+	for _, v := range cfg.NamedVectors {
+		col.NamedVectors = append(col.NamedVectors, &models.NamedVector{
+			Name:        v.Name,
+			IndexType:   v.IndexType,
+			IndexParams: v.IndexParams,
+		})
+	}
+
+	for _, m := range cfg.Mappings {
+		col.Mappings = append(col.Mappings, &models.Mapping{
+			Name:       m.Name,
+			Type:       m.Type,
+			Indexed:    m.Indexed,
+			Filterable: m.Filterable,
+			Embed:      m.Embed,
+		})
 	}
 
 	return collection.NewGetCollectionOK().WithPayload(&col)
@@ -62,18 +89,40 @@ func (h *CollectionHandler) addCollection(params collection.AddCollectionParams)
 		EmbedderType:   params.Collection.EmbedderType,
 		IndexParams:    params.Collection.IndexParams,
 		EmbedderConfig: params.Collection.EmbedderConfig,
-		Mappings:       params.Collection.Mappings,
+		Mappings:       make([]mappings.Mapping, 0, len(params.Collection.Mappings)),
 		DataType:       params.Collection.DataType,
+		VectorType:     params.Collection.VectorType,
 	}
 
-	_, err := h.db.CreateCollection(ctx, &cfg)
-	if err != nil {
-		code := http.StatusInternalServerError
-		if errors.Is(err, db.ErrValidationFailed) {
-			code = http.StatusBadRequest
+	for _, m := range params.Collection.Mappings {
+		if m == nil {
+			continue
 		}
 
-		return middleware.Error(code, handleError(err))
+		cfg.Mappings = append(cfg.Mappings, mappings.Mapping{
+			Name:       m.Name,
+			Type:       m.Type,
+			Indexed:    m.Indexed,
+			Filterable: m.Filterable,
+			Embed:      m.Embed,
+		})
+	}
+
+	for _, v := range params.Collection.NamedVectors {
+		if v == nil {
+			continue
+		}
+
+		cfg.NamedVectors = append(cfg.NamedVectors, index.NamedVector{
+			Name:        v.Name,
+			IndexType:   v.IndexType,
+			IndexParams: v.IndexParams,
+		})
+	}
+
+	_, err := h.db.CreateCollection(ctx, &cfg)
+	if err != nil {
+		return middleware.Error(errorCode(err), handleError(err))
 	}
 
 	return collection.NewAddCollectionCreated().WithPayload(&models.CollectionCreated{CollectionName: cfg.Name})
@@ -84,14 +133,74 @@ func (h *CollectionHandler) deleteCollection(params collection.DeleteCollectionP
 	ctx := params.HTTPRequest.Context()
 
 	err := h.db.DeleteCollection(ctx, params.CollectionName)
+	if err != nil {
+		return middleware.Error(errorCode(err), handleError(err))
+	}
+
+	return collection.NewDeleteCollectionOK().WithPayload(&models.APIResponse{Message: "deleted successfully"})
+}
+
+// backupCollection handler for writing a backup of a collection to the backups directory
+func (h *CollectionHandler) backupCollection(params collection.BackupCollectionParams) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	name := db.BackupName(params.CollectionName, time.Now())
+
+	if err := os.MkdirAll(h.backupsPath, 0o750); err != nil {
+		return middleware.Error(http.StatusInternalServerError, handleError(err))
+	}
+
+	// the archive is written to a temporary file so that a failed backup doesn't leave a partial one
+	f, err := os.CreateTemp(h.backupsPath, ".backup-*")
+	if err != nil {
+		return middleware.Error(http.StatusInternalServerError, handleError(err))
+	}
+	defer os.Remove(f.Name())
+
+	err = h.db.BackupCollection(ctx, params.CollectionName, f)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return middleware.Error(errorCode(err), handleError(err))
+	}
+
+	if err = os.Rename(f.Name(), filepath.Join(h.backupsPath, name)); err != nil {
+		return middleware.Error(http.StatusInternalServerError, handleError(err))
+	}
+
+	return collection.NewBackupCollectionCreated().WithPayload(&models.Backup{Name: name, Collection: params.CollectionName})
+}
+
+// restoreCollection handler for creating a collection from a backup in the backups directory
+func (h *CollectionHandler) restoreCollection(params collection.RestoreCollectionParams) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	if params.BackupName != filepath.Base(params.BackupName) || strings.HasPrefix(params.BackupName, ".") {
+		return middleware.Error(http.StatusBadRequest, handleError(fmt.Errorf("invalid backup name %q", params.BackupName)))
+	}
+
+	f, err := os.Open(filepath.Join(h.backupsPath, params.BackupName))
 	if err != nil {
 		code := http.StatusInternalServerError
-		if errors.Is(err, db.ErrValidationFailed) {
-			code = http.StatusBadRequest
+		if errors.Is(err, os.ErrNotExist) {
+			code = http.StatusNotFound
 		}
 
 		return middleware.Error(code, handleError(err))
 	}
+	defer f.Close()
 
-	return collection.NewDeleteCollectionOK().WithPayload(&models.APIResponse{Message: "deleted successfully"})
+	c, err := h.db.RestoreCollection(ctx, f)
+	if err != nil {
+		return middleware.Error(errorCode(err), handleError(err))
+	}
+
+	cfg, err := c.GetConfig()
+	if err != nil {
+		return middleware.Error(http.StatusInternalServerError, handleError(err))
+	}
+
+	return collection.NewRestoreCollectionCreated().WithPayload(&models.CollectionCreated{CollectionName: cfg.Name})
 }
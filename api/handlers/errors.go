@@ -1,5 +1,11 @@
 package handlers
 
+import (
+	"Vectory/db"
+	"errors"
+	"net/http"
+)
+
 type GeneralError struct {
 	Message string `json:"message"`
 }
@@ -11,3 +17,20 @@ func (e GeneralError) Error() string {
 func handleError(err error) error {
 	return GeneralError{Message: err.Error()}
 }
+
+// errorCode maps errors returned from the db to their http status code.
+func errorCode(err error) int {
+	if errors.Is(err, db.ErrCollectionDoesntExist) || errors.Is(err, db.ErrObjectDoesntExist) {
+		return http.StatusNotFound
+	}
+
+	if errors.Is(err, db.ErrCollectionAlreadyExists) {
+		return http.StatusConflict
+	}
+
+	if errors.Is(err, db.ErrValidationFailed) || errors.Is(err, db.ErrMissingVectorAndEmbedder) {
+		return http.StatusBadRequest
+	}
+
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"Vectory/db"
+	collectionent "Vectory/entities/collection"
+	"Vectory/entities/index"
+	objstoreentities "Vectory/entities/objstore"
+	"Vectory/gen/api/models"
+	"Vectory/gen/api/restapi/operations/collection"
+	"Vectory/gen/api/restapi/operations/object"
+	"context"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+func TestHandlers_ErrorCodes(t *testing.T) {
+	ctx := context.Background()
+	filesPath := "./tmp_handlers"
+	defer os.RemoveAll(filesPath)
+
+	vectory, err := db.Open(filesPath)
+	require.NoError(t, err)
+	defer vectory.Close()
+
+	cfg := collectionent.Collection{Name: "test_collection", IndexType: index.Hnsw, DataType: "text", IndexParams: index.DefaultHnswParams}
+	c, err := vectory.CreateCollection(ctx, &cfg)
+	require.NoError(t, err)
+
+	obj := &objstoreentities.Object{Vector: []float32{1, 2, 3}}
+	require.NoError(t, c.Insert(ctx, obj))
+
+	collectionHandler := CollectionHandler{db: vectory, backupsPath: filesPath}
+	objectHandler := ObjectHandler{db: vectory}
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+
+	// statusCode returns the status code r writes
+	statusCode := func(r middleware.Responder) int {
+		rec := httptest.NewRecorder()
+		r.WriteResponse(rec, runtime.JSONProducer())
+
+		return rec.Code
+	}
+
+	tests := []struct {
+		name      string
+		responder middleware.Responder
+		code      int
+	}{
+		{
+			name:      "get missing collection",
+			responder: collectionHandler.getCollection(collection.GetCollectionParams{HTTPRequest: req, CollectionName: "missing"}),
+			code:      http.StatusNotFound,
+		},
+		{
+			name:      "delete missing collection",
+			responder: collectionHandler.deleteCollection(collection.DeleteCollectionParams{HTTPRequest: req, CollectionName: "missing"}),
+			code:      http.StatusNotFound,
+		},
+		{
+			name: "add existing collection",
+			responder: collectionHandler.addCollection(collection.AddCollectionParams{HTTPRequest: req, Collection: &models.Collection{
+				Name:        cfg.Name,
+				IndexType:   cfg.IndexType,
+				DataType:    cfg.DataType,
+				IndexParams: cfg.IndexParams,
+			}}),
+			code: http.StatusConflict,
+		},
+		{
+			name:      "add invalid collection",
+			responder: collectionHandler.addCollection(collection.AddCollectionParams{HTTPRequest: req, Collection: &models.Collection{Name: "invalid"}}),
+			code:      http.StatusBadRequest,
+		},
+		{
+			name:      "insert to missing collection",
+			responder: objectHandler.insertObject(object.InsertObjectParams{HTTPRequest: req, CollectionName: "missing", Object: &models.Object{Vector: []float32{1, 2, 3}}}),
+			code:      http.StatusNotFound,
+		},
+		{
+			name:      "get from missing collection",
+			responder: objectHandler.getObjects(object.GetObjectsParams{HTTPRequest: req, CollectionName: "missing", Ids: []uint64{obj.Id}}),
+			code:      http.StatusNotFound,
+		},
+		{
+			name:      "delete from missing collection",
+			responder: objectHandler.deleteObject(object.DeleteObjectParams{HTTPRequest: req, CollectionName: "missing", ObjectID: obj.Id}),
+			code:      http.StatusNotFound,
+		},
+		{
+			name:      "delete missing object",
+			responder: objectHandler.deleteObject(object.DeleteObjectParams{HTTPRequest: req, CollectionName: cfg.Name, ObjectID: obj.Id + 1}),
+			code:      http.StatusNotFound,
+		},
+		{
+			name:      "delete object",
+			responder: objectHandler.deleteObject(object.DeleteObjectParams{HTTPRequest: req, CollectionName: cfg.Name, ObjectID: obj.Id}),
+			code:      http.StatusOK,
+		},
+		{
+			name:      "search missing collection",
+			responder: objectHandler.semanticSearch(object.SemanticSearchParams{HTTPRequest: req, CollectionName: "missing", Query: &models.SearchQuery{Vector: []float32{1, 2, 3}, K: 1}}),
+			code:      http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.code, statusCode(tt.responder))
+		})
+	}
+
+	t.Run("update missing object", func(t *testing.T) {
+		err := c.Update(ctx, &objstoreentities.Object{Id: obj.Id + 1, Vector: []float32{1, 2, 3}})
+		require.ErrorIs(t, err, db.ErrValidationFailed)
+		require.Equal(t, http.StatusNotFound, errorCode(err))
+	})
+}
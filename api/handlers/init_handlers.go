@@ -5,8 +5,11 @@ import (
 	"Vectory/gen/api/restapi/operations"
 )
 
-// InitHandlers registers api handlers for the api
-func InitHandlers(api *operations.VectoryAPI, db *db.DB) {
-	collectionHandler := CollectionHandler{db: db}
+// InitHandlers registers api handlers for the api, backups of collections are kept in backupsPath
+func InitHandlers(api *operations.VectoryAPI, db *db.DB, backupsPath string) {
+	collectionHandler := CollectionHandler{db: db, backupsPath: backupsPath}
 	collectionHandler.initHandlers(api)
+
+	objectHandler := ObjectHandler{db: db}
+	objectHandler.initHandlers(api)
 }
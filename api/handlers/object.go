@@ -0,0 +1,383 @@
+package handlers
+
+import (
+	"Vectory/db"
+	collectionent "Vectory/entities/collection"
+	"Vectory/entities/filters"
+	objstoreentities "Vectory/entities/objstore"
+	"Vectory/entities/search"
+	"Vectory/gen/api/models"
+	"Vectory/gen/api/restapi/operations"
+	"Vectory/gen/api/restapi/operations/object"
+	"fmt"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"net/http"
+)
+
+type ObjectHandler struct {
+	db *db.DB
+}
+
+func (h *ObjectHandler) initHandlers(api *operations.VectoryAPI) {
+	api.ObjectInsertObjectHandler = object.InsertObjectHandlerFunc(h.insertObject)
+	api.ObjectInsertObjectsBatchHandler = object.InsertObjectsBatchHandlerFunc(h.insertObjectsBatch)
+	api.ObjectImportObjectsHandler = object.ImportObjectsHandlerFunc(h.importObjects)
+	api.ObjectGetObjectsHandler = object.GetObjectsHandlerFunc(h.getObjects)
+	api.ObjectDeleteObjectHandler = object.DeleteObjectHandlerFunc(h.deleteObject)
+	api.ObjectUpsertObjectHandler = object.UpsertObjectHandlerFunc(h.upsertObject)
+	api.ObjectGetObjectByKeyHandler = object.GetObjectByKeyHandlerFunc(h.getObjectByKey)
+	api.ObjectDeleteObjectByKeyHandler = object.DeleteObjectByKeyHandlerFunc(h.deleteObjectByKey)
+	api.ObjectSemanticSearchHandler = object.SemanticSearchHandlerFunc(h.semanticSearch)
+	api.ObjectRangeSearchHandler = object.RangeSearchHandlerFunc(h.rangeSearch)
+}
+
+// insertObject handler for inserting a single object to a collection
+func (h *ObjectHandler) insertObject(params object.InsertObjectParams) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	c, err := h.db.GetCollection(ctx, params.CollectionName)
+	if err != nil {
+		return middleware.Error(errorCode(err), handleError(err))
+	}
+
+	obj := objstoreentities.Object{
+		Key:        params.Object.Key,
+		Properties: params.Object.Properties,
+		Vector:     params.Object.Vector,
+		Vectors:    params.Object.Vectors,
+	}
+
+	if err = c.Insert(ctx, &obj); err != nil {
+		return middleware.Error(errorCode(err), handleError(err))
+	}
+
+	return object.NewInsertObjectCreated().WithPayload(&models.ObjectCreated{ID: obj.Id})
+}
+
+// insertObjectsBatch handler for inserting a batch of objects to a collection
+func (h *ObjectHandler) insertObjectsBatch(params object.InsertObjectsBatchParams) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	c, err := h.db.GetCollection(ctx, params.CollectionName)
+	if err != nil {
+		return middleware.Error(errorCode(err), handleError(err))
+	}
+
+	objs := make([]*objstoreentities.Object, 0, len(params.Objects))
+	for _, o := range params.Objects {
+		objs = append(objs, &objstoreentities.Object{
+			Key:        o.Key,
+			Properties: o.Properties,
+			Vector:     o.Vector,
+			Vectors:    o.Vectors,
+		})
+	}
+
+	res, err := c.InsertBatchWithResults(ctx, objs)
+	if err != nil {
+		return middleware.Error(errorCode(err), handleError(err))
+	}
+
+	payload := &models.ObjectsBatchCreated{
+		Ids:     make([]uint64, 0, len(objs)-res.Failed),
+		Results: make([]*models.BatchObjectResult, 0, len(res.Objects)),
+		Failed:  int64(res.Failed),
+		Partial: res.Partial(),
+	}
+
+	for _, o := range res.Objects {
+		if o.Err != nil {
+			payload.Results = append(payload.Results, &models.BatchObjectResult{Error: o.Err.Error()})
+			continue
+		}
+
+		payload.Ids = append(payload.Ids, o.Id)
+		payload.Results = append(payload.Results, &models.BatchObjectResult{ID: o.Id})
+	}
+
+	if res.Failed > 0 {
+		return object.NewInsertObjectsBatchMultiStatus().WithPayload(payload)
+	}
+
+	return object.NewInsertObjectsBatchCreated().WithPayload(payload)
+}
+
+// importObjects handler for importing the objects of JSON lines to a collection
+func (h *ObjectHandler) importObjects(params object.ImportObjectsParams) middleware.Responder {
+	defer params.Records.Close()
+
+	ctx := params.HTTPRequest.Context()
+
+	c, err := h.db.GetCollection(ctx, params.CollectionName)
+	if err != nil {
+		return middleware.Error(errorCode(err), handleError(err))
+	}
+
+	mapping, err := collectionent.ParseImportMapping(swag.StringValue(params.Key), swag.StringValue(params.Vector),
+		swag.StringValue(params.Vectors), swag.StringValue(params.Properties))
+	if err != nil {
+		return middleware.Error(http.StatusBadRequest, handleError(err))
+	}
+
+	opts := collectionent.ImportOptions{
+		Mapping:   mapping,
+		BatchSize: int(swag.Int64Value(params.BatchSize)),
+		Skip:      int(swag.Int64Value(params.Skip)),
+	}
+
+	res, err := c.Import(ctx, params.Records, opts)
+	if err != nil {
+		if res == nil {
+			return middleware.Error(errorCode(err), handleError(err))
+		}
+
+		return middleware.Error(errorCode(err), handleError(fmt.Errorf("import stopped after %d records: %w", res.Records, err)))
+	}
+
+	payload := &models.ImportResult{
+		Records:  int64(res.Records),
+		Imported: int64(res.Imported),
+		Failed:   int64(res.Failed),
+		Errors:   make([]*models.ImportError, 0, len(res.Errors)),
+	}
+
+	for _, e := range res.Errors {
+		payload.Errors = append(payload.Errors, &models.ImportError{Record: int64(e.Record), Error: e.Err.Error()})
+	}
+
+	return object.NewImportObjectsOK().WithPayload(payload)
+}
+
+// getObjects handler for getting objects from a collection by their ids
+func (h *ObjectHandler) getObjects(params object.GetObjectsParams) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	c, err := h.db.GetCollection(ctx, params.CollectionName)
+	if err != nil {
+		return middleware.Error(errorCode(err), handleError(err))
+	}
+
+	objs, err := c.Get(params.Ids)
+	if err != nil {
+		return middleware.Error(errorCode(err), handleError(err))
+	}
+
+	payload := make([]*models.Object, 0, len(objs))
+	for _, o := range objs {
+		payload = append(payload, &models.Object{
+			ID:         o.Id,
+			Key:        o.Key,
+			Properties: o.Properties,
+			Vector:     o.Vector,
+			Vectors:    o.Vectors,
+		})
+	}
+
+	return object.NewGetObjectsOK().WithPayload(payload)
+}
+
+// deleteObject handler for deleting an object from a collection
+func (h *ObjectHandler) deleteObject(params object.DeleteObjectParams) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	c, err := h.db.GetCollection(ctx, params.CollectionName)
+	if err != nil {
+		return middleware.Error(errorCode(err), handleError(err))
+	}
+
+	objs, err := c.Get([]uint64{params.ObjectID})
+	if err != nil {
+		return middleware.Error(errorCode(err), handleError(err))
+	}
+
+	if len(objs) == 0 {
+		return object.NewDeleteObjectNotFound()
+	}
+
+	if err = c.Delete(params.ObjectID); err != nil {
+		return middleware.Error(errorCode(err), handleError(err))
+	}
+
+	return object.NewDeleteObjectOK().WithPayload(&models.APIResponse{Message: "deleted successfully"})
+}
+
+// upsertObject handler for updating the object with the same key in a collection, or inserting it if there's none
+func (h *ObjectHandler) upsertObject(params object.UpsertObjectParams) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	c, err := h.db.GetCollection(ctx, params.CollectionName)
+	if err != nil {
+		return middleware.Error(errorCode(err), handleError(err))
+	}
+
+	obj := objstoreentities.Object{
+		Key:        params.Object.Key,
+		Properties: params.Object.Properties,
+		Vector:     params.Object.Vector,
+		Vectors:    params.Object.Vectors,
+	}
+
+	if err = c.Upsert(ctx, &obj); err != nil {
+		return middleware.Error(errorCode(err), handleError(err))
+	}
+
+	return object.NewUpsertObjectOK().WithPayload(&models.ObjectCreated{ID: obj.Id})
+}
+
+// getObjectByKey handler for getting an object from a collection by its key
+func (h *ObjectHandler) getObjectByKey(params object.GetObjectByKeyParams) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	c, err := h.db.GetCollection(ctx, params.CollectionName)
+	if err != nil {
+		return middleware.Error(errorCode(err), handleError(err))
+	}
+
+	objs, err := c.GetByKeys([]string{params.ObjectKey})
+	if err != nil {
+		return middleware.Error(errorCode(err), handleError(err))
+	}
+
+	if len(objs) == 0 {
+		return object.NewGetObjectByKeyNotFound()
+	}
+
+	return object.NewGetObjectByKeyOK().WithPayload(&models.Object{
+		ID:         objs[0].Id,
+		Key:        objs[0].Key,
+		Properties: objs[0].Properties,
+		Vector:     objs[0].Vector,
+		Vectors:    objs[0].Vectors,
+	})
+}
+
+// deleteObjectByKey handler for deleting an object from a collection by its key
+func (h *ObjectHandler) deleteObjectByKey(params object.DeleteObjectByKeyParams) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	c, err := h.db.GetCollection(ctx, params.CollectionName)
+	if err != nil {
+		return middleware.Error(errorCode(err), handleError(err))
+	}
+
+	if err = c.DeleteByKey(params.ObjectKey); err != nil {
+		return middleware.Error(errorCode(err), handleError(err))
+	}
+
+	return object.NewDeleteObjectByKeyOK().WithPayload(&models.APIResponse{Message: "deleted successfully"})
+}
+
+// semanticSearch handler for performing a semantic search over a collection
+func (h *ObjectHandler) semanticSearch(params object.SemanticSearchParams) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	c, err := h.db.GetCollection(ctx, params.CollectionName)
+	if err != nil {
+		return middleware.Error(errorCode(err), handleError(err))
+	}
+
+	obj := objstoreentities.Object{
+		Properties: params.Query.Properties,
+		Vector:     params.Query.Vector,
+		Vectors:    params.Query.Vectors,
+	}
+
+	opts := search.Options{
+		K:               int(params.Query.K),
+		Vector:          params.Query.VectorName,
+		Ef:              int(params.Query.Ef),
+		MaxDistance:     params.Query.MaxDistance,
+		IncludeVector:   params.Query.IncludeVector,
+		IncludeDistance: params.Query.IncludeDistance == nil || *params.Query.IncludeDistance,
+	}
+
+	res, err := c.SemanticSearch(ctx, &obj, opts, toFilter(params.Query.Filter), toHybridParams(params.Query.Hybrid))
+	if err != nil {
+		return middleware.Error(errorCode(err), handleError(err))
+	}
+
+	return object.NewSemanticSearchOK().WithPayload(toSearchResult(res, opts.IncludeDistance))
+}
+
+// rangeSearch handler for returning the objects of a collection within a distance of the query
+func (h *ObjectHandler) rangeSearch(params object.RangeSearchParams) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	c, err := h.db.GetCollection(ctx, params.CollectionName)
+	if err != nil {
+		return middleware.Error(errorCode(err), handleError(err))
+	}
+
+	obj := objstoreentities.Object{
+		Properties: params.Query.Properties,
+		Vector:     params.Query.Vector,
+		Vectors:    params.Query.Vectors,
+	}
+
+	res, err := c.RangeSearch(ctx, &obj, *params.Query.Radius, int(params.Query.Limit), params.Query.VectorName)
+	if err != nil {
+		return middleware.Error(errorCode(err), handleError(err))
+	}
+
+	return object.NewRangeSearchOK().WithPayload(toSearchResult(res, true))
+}
+
+// toSearchResult converts a search result to its REST model
+func toSearchResult(res *collectionent.SemanticSearchResult, includeDistance bool) *models.SearchResult {
+	objs := make([]*models.ObjectWithDistance, 0, len(res.Objects))
+	for _, o := range res.Objects {
+		m := models.ObjectWithDistance{
+			ID:         o.Id,
+			Key:        o.Key,
+			Properties: o.Properties,
+			Vector:     o.Vector,
+			Vectors:    o.Vectors,
+			Score:      o.Score,
+		}
+
+		if includeDistance {
+			distance := o.Distance
+			m.Distance = &distance
+		}
+
+		objs = append(objs, &m)
+	}
+
+	return &models.SearchResult{
+		Hits:    int64(res.Hits),
+		Objects: objs,
+	}
+}
+
+// toHybridParams converts the REST hybrid search model to hybrid search params
+func toHybridParams(h *models.HybridSearch) *collectionent.HybridParams {
+	if h == nil {
+		return nil
+	}
+
+	return &collectionent.HybridParams{
+		Query:  h.Query,
+		Fusion: h.Fusion,
+		Alpha:  h.Alpha,
+	}
+}
+
+// toFilter converts the REST filter model to a filter entity
+func toFilter(f *models.SearchFilter) *filters.Filter {
+	if f == nil {
+		return nil
+	}
+
+	operands := make([]*filters.Filter, 0, len(f.Operands))
+	for _, o := range f.Operands {
+		operands = append(operands, toFilter(o))
+	}
+
+	return &filters.Filter{
+		Operator: f.Operator,
+		Property: f.Property,
+		Value:    f.Value,
+		Operands: operands,
+	}
+}
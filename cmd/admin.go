@@ -0,0 +1,452 @@
+package main
+
+import (
+	"Vectory/db"
+	"Vectory/db/core/index/hnsw"
+	"Vectory/db/core/objstore"
+	"Vectory/db/metadata"
+	"Vectory/entities/collection"
+	"Vectory/entities/index"
+	"Vectory/gen/ent"
+	"bufio"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+// openMetadata opens the metadata of the database in the config at cfgPath, the data directory must exist.
+func openMetadata(cfgPath string) (*Config, *metadata.MetaManager, error) {
+	cfg, err := readConfig(cfgPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the metadata manager would create a new database if it's missing
+	if _, err = os.Stat(cfg.FilesPath + "/metadata.db"); err != nil {
+		return nil, nil, fmt.Errorf("no vectory database in %s: %w", cfg.FilesPath, err)
+	}
+
+	mm, err := metadata.NewMetaManager(cfg.FilesPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cfg, mm, nil
+}
+
+// collectionConfig returns the configuration of the collection with name.
+func collectionConfig(mm *metadata.MetaManager, name string) (*collection.Collection, error) {
+	col, err := mm.GetCollection(context.Background(), name)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: %s", metadata.ErrCollectionDoesntExist, name)
+		}
+
+		return nil, err
+	}
+
+	return metadata.Config(col), nil
+}
+
+// collectionFlags returns the flags of a command on the collection given by -collection.
+func collectionFlags(name string, cfgPath, collectionName *string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+	fs.StringVar(cfgPath, "config", "", "config path for Vectory")
+	fs.StringVar(collectionName, "collection", "", "name of the collection")
+
+	return fs
+}
+
+// collectionsCommand lists the collections of the database.
+func collectionsCommand(args []string) error {
+	var cfgPath string
+
+	fs := flag.NewFlagSet("collections", flag.ExitOnError)
+	fs.StringVar(&cfgPath, "config", "", "config path for Vectory")
+	_ = fs.Parse(args)
+
+	_, mm, err := openMetadata(cfgPath)
+	if err != nil {
+		return err
+	}
+	defer mm.Close()
+
+	cols, err := mm.GetCollections(context.Background())
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(cols, func(i, j int) bool {
+		return cols[i].Name < cols[j].Name
+	})
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tINDEX\tDATA\tEMBEDDER\tNAMED VECTORS")
+
+	for _, col := range cols {
+		names := make([]string, 0, len(col.NamedVectors))
+		for _, v := range col.NamedVectors {
+			names = append(names, fmt.Sprintf("%s (%s)", v.Name, v.IndexType))
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", col.Name, col.IndexType, col.DataType, col.EmbedderType, strings.Join(names, ", "))
+	}
+
+	return w.Flush()
+}
+
+// showCommand prints the configuration of a collection, its number of objects and the size of its files.
+func showCommand(args []string) error {
+	var cfgPath, name string
+
+	fs := collectionFlags("show", &cfgPath, &name)
+	_ = fs.Parse(args)
+
+	cfg, mm, err := openMetadata(cfgPath)
+	if err != nil {
+		return err
+	}
+	defer mm.Close()
+
+	c, err := collectionConfig(mm, name)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("%s/%s", cfg.FilesPath, c.Name)
+
+	stores, err := objstore.NewStores(path)
+	if err != nil {
+		return err
+	}
+
+	objects := stores.Size()
+
+	if err = stores.Close(); err != nil {
+		return err
+	}
+
+	var diskBytes int64
+
+	err = filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
+		if err == nil && info.Mode().IsRegular() {
+			diskBytes += info.Size()
+		}
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return printJSON(map[string]interface{}{"config": c, "objects": objects, "disk_bytes": diskBytes})
+}
+
+// hnswPath returns the directory of the hnsw index of the collection, or of its named vector when vector isn't empty.
+func hnswPath(cfg *Config, c *collection.Collection, vector string) (string, error) {
+	indexType := c.IndexType
+
+	if vector != "" {
+		indexType = ""
+
+		for _, v := range c.NamedVectors {
+			if v.Name == vector {
+				indexType = v.IndexType
+			}
+		}
+
+		if indexType == "" {
+			return "", fmt.Errorf("collection %s has no named vector %s", c.Name, vector)
+		}
+	}
+
+	if indexType != index.Hnsw {
+		return "", fmt.Errorf("index of collection %s is %s instead of %s", c.Name, indexType, index.Hnsw)
+	}
+
+	return db.IndexPath(cfg.FilesPath, c.Name, vector), nil
+}
+
+// indexCommand parses the flags of a command on the hnsw index of a collection and returns the index's directory.
+func indexCommand(fs *flag.FlagSet, args []string) (string, error) {
+	var cfgPath, name, vector string
+
+	fs.StringVar(&cfgPath, "config", "", "config path for Vectory")
+	fs.StringVar(&name, "collection", "", "name of the collection")
+	fs.StringVar(&vector, "vector", "", "named vector whose index is used, the objects' vector if empty")
+	_ = fs.Parse(args)
+
+	cfg, mm, err := openMetadata(cfgPath)
+	if err != nil {
+		return "", err
+	}
+	defer mm.Close()
+
+	c, err := collectionConfig(mm, name)
+	if err != nil {
+		return "", err
+	}
+
+	return hnswPath(cfg, c, vector)
+}
+
+// walCommand prints the records of the WAL of an hnsw index as JSON lines, or verifies that the index can be
+// restored from its snapshot and WAL.
+func walCommand(args []string) error {
+	var verify bool
+
+	fs := flag.NewFlagSet("wal", flag.ExitOnError)
+	fs.BoolVar(&verify, "verify", false, "verify the snapshot, WAL and graph instead of printing the WAL")
+
+	path, err := indexCommand(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if verify {
+		stats, err := hnsw.Inspect(path)
+		if err != nil {
+			return err
+		}
+
+		for _, p := range stats.Problems {
+			fmt.Println(p)
+		}
+
+		if len(stats.Problems) > 0 {
+			return fmt.Errorf("found %d problems", len(stats.Problems))
+		}
+
+		fmt.Printf("ok, %d vertices and %d WAL records after the snapshot\n", stats.Vertices, stats.WALRecords)
+
+		return nil
+	}
+
+	w := bufio.NewWriter(os.Stdout)
+	enc := json.NewEncoder(w)
+
+	if err = hnsw.ReadWAL(path, func(r hnsw.WALRecord) error { return enc.Encode(r) }); err != nil {
+		return err
+	}
+
+	return w.Flush()
+}
+
+// statsCommand prints the stats of the graph of an hnsw index.
+func statsCommand(args []string) error {
+	path, err := indexCommand(flag.NewFlagSet("stats", flag.ExitOnError), args)
+	if err != nil {
+		return err
+	}
+
+	stats, err := hnsw.Inspect(path)
+	if err != nil {
+		return err
+	}
+
+	return printJSON(stats)
+}
+
+// exportCommand writes the objects of a collection as JSON lines ordered by their ids.
+func exportCommand(args []string) error {
+	var cfgPath, name, out string
+
+	fs := collectionFlags("export", &cfgPath, &name)
+	fs.StringVar(&out, "out", "", "path of the exported objects, stdout if empty")
+	_ = fs.Parse(args)
+
+	cfg, mm, err := openMetadata(cfgPath)
+	if err != nil {
+		return err
+	}
+	defer mm.Close()
+
+	c, err := collectionConfig(mm, name)
+	if err != nil {
+		return err
+	}
+
+	stores, err := objstore.NewStores(fmt.Sprintf("%s/%s", cfg.FilesPath, c.Name))
+	if err != nil {
+		return err
+	}
+	defer stores.Close()
+
+	f := os.Stdout
+	if out != "" {
+		if f, err = os.Create(out); err != nil {
+			return err
+		}
+		defer f.Close()
+	}
+
+	if _, err = db.ExportObjects(context.Background(), stores, f); err != nil {
+		return err
+	}
+
+	if out != "" {
+		return f.Close()
+	}
+
+	return nil
+}
+
+// importProgress is the checkpoint of an import from a file, written next to it after each batch
+type importProgress struct {
+	Records int `json:"records"`
+}
+
+// importCommand inserts the objects of JSON lines to a collection, the objects get new ids. an import from a file
+// keeps its progress in <file>.progress so an interrupted import can be resumed with -resume, the progress of a
+// bulk import is kept only once it stops.
+func importCommand(args []string) error {
+	var (
+		cfgPath, name, in, key, vector, vectors, properties string
+		resume                                              bool
+		opts                                                collection.ImportOptions
+	)
+
+	fs := collectionFlags("import", &cfgPath, &name)
+	fs.StringVar(&in, "in", "", "path of the objects to import, stdin if empty")
+	fs.IntVar(&opts.BatchSize, "batch", collection.DefaultImportBatchSize, "number of objects inserted in each batch")
+	fs.StringVar(&key, "key", "key", "field of the objects' keys")
+	fs.StringVar(&vector, "vector", "vector", "field of the objects' vectors")
+	fs.StringVar(&vectors, "vectors", "", "comma separated name=field of the objects' named vectors, the vectors object if empty")
+	fs.StringVar(&properties, "properties", "", "comma separated fields of the objects' properties, the properties object if empty")
+	fs.BoolVar(&resume, "resume", false, "resume an interrupted import of -in from its progress file")
+	fs.BoolVar(&opts.Bulk, "bulk", false, "build the vector indexes without logging each insert, for initial loads of empty collections")
+	_ = fs.Parse(args)
+
+	if opts.BatchSize <= 0 {
+		return fmt.Errorf("batch must be positive")
+	}
+
+	var err error
+	if opts.Mapping, err = collection.ParseImportMapping(key, vector, vectors, properties); err != nil {
+		return err
+	}
+
+	r := os.Stdin
+	if in != "" {
+		f, err := os.Open(in)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		r = f
+	}
+
+	progressPath := in + ".progress"
+
+	if resume {
+		if in == "" {
+			return fmt.Errorf("resume requires in")
+		}
+
+		b, err := os.ReadFile(progressPath)
+		if err != nil {
+			return err
+		}
+
+		var p importProgress
+		if err = json.Unmarshal(b, &p); err != nil {
+			return fmt.Errorf("invalid progress file %s: %w", progressPath, err)
+		}
+
+		opts.Skip = p.Records
+	}
+
+	writeProgress := func(res *collection.ImportResult) {
+		if in == "" {
+			return
+		}
+
+		b, _ := json.Marshal(importProgress{Records: res.Records})
+		if err := os.WriteFile(progressPath, b, 0o644); err != nil {
+			fmt.Fprintf(os.Stderr, "failed writing progress file %s: %s\n", progressPath, err)
+		}
+	}
+
+	opts.Progress = func(res collection.ImportResult) {
+		fmt.Fprintf(os.Stderr, "%d records, %d imported, %d failed\n", res.Records, res.Imported, res.Failed)
+
+		if !opts.Bulk {
+			writeProgress(&res)
+		}
+	}
+
+	_, vectoryDB, err := openDB(cfgPath)
+	if err != nil {
+		return err
+	}
+	defer vectoryDB.Close()
+
+	ctx := context.Background()
+
+	c, err := vectoryDB.GetCollection(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.Import(ctx, r, opts)
+	if err != nil {
+		if res == nil {
+			return err
+		}
+
+		if opts.Bulk {
+			writeProgress(res)
+		}
+
+		return fmt.Errorf("import stopped after %d records: %w", res.Records, err)
+	}
+
+	for _, e := range res.Errors {
+		fmt.Fprintf(os.Stderr, "record %d: %s\n", e.Record, e.Err)
+	}
+
+	if in != "" {
+		if err = os.Remove(progressPath); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	fmt.Printf("imported %d objects, %d failed\n", res.Imported, res.Failed)
+
+	return nil
+}
+
+// compactCommand removes the deleted objects of a collection from its indexes and reclaims their space.
+func compactCommand(args []string) error {
+	var cfgPath, name string
+
+	fs := collectionFlags("compact", &cfgPath, &name)
+	_ = fs.Parse(args)
+
+	_, vectoryDB, err := openDB(cfgPath)
+	if err != nil {
+		return err
+	}
+	defer vectoryDB.Close()
+
+	c, err := vectoryDB.GetCollection(context.Background(), name)
+	if err != nil {
+		return err
+	}
+
+	return c.Compact()
+}
+
+func printJSON(v interface{}) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(v)
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"Vectory/db"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// commands are run on the data directory of a stopped server, since the database can't be opened twice
+var commands = map[string]func(args []string) error{
+	"backup":      backupCommand,
+	"restore":     restoreCommand,
+	"collections": collectionsCommand,
+	"show":        showCommand,
+	"wal":         walCommand,
+	"stats":       statsCommand,
+	"export":      exportCommand,
+	"import":      importCommand,
+	"compact":     compactCommand,
+}
+
+func runCommand(name string, args []string) error {
+	command, ok := commands[name]
+	if !ok {
+		names := make([]string, 0, len(commands))
+		for n := range commands {
+			names = append(names, n)
+		}
+
+		sort.Strings(names)
+
+		return fmt.Errorf("unknown command, expected one of %s", strings.Join(names, ", "))
+	}
+
+	return command(args)
+}
+
+// openDB opens the database in the config at cfgPath.
+func openDB(cfgPath string) (*Config, *db.DB, error) {
+	cfg, err := readConfig(cfgPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vectoryDB, err := db.Open(cfg.FilesPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cfg, vectoryDB, nil
+}
+
+// backupCommand writes a backup of a collection to a file, by default in the backups directory.
+func backupCommand(args []string) error {
+	var cfgPath, name, out string
+
+	fs := collectionFlags("backup", &cfgPath, &name)
+	fs.StringVar(&out, "out", "", "path of the backup, a new file in the backups directory if empty")
+	_ = fs.Parse(args)
+
+	if name == "" {
+		return fmt.Errorf("missing -collection")
+	}
+
+	cfg, vectoryDB, err := openDB(cfgPath)
+	if err != nil {
+		return err
+	}
+	defer vectoryDB.Close()
+
+	if out == "" {
+		if err = os.MkdirAll(cfg.BackupsPath, 0o750); err != nil {
+			return err
+		}
+
+		out = filepath.Join(cfg.BackupsPath, db.BackupName(name, time.Now()))
+	}
+
+	f, err := os.OpenFile(out, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o640)
+	if err != nil {
+		return err
+	}
+
+	err = vectoryDB.BackupCollection(context.Background(), name, f)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		os.Remove(out)
+		return err
+	}
+
+	fmt.Println(out)
+
+	return nil
+}
+
+// restoreCommand creates the collection of a backup file.
+func restoreCommand(args []string) error {
+	var cfgPath, in string
+
+	fs := flag.NewFlagSet("restore", flag.ExitOnError)
+	fs.StringVar(&cfgPath, "config", "", "config path for Vectory")
+	fs.StringVar(&in, "in", "", "path of the backup to restore")
+	_ = fs.Parse(args)
+
+	if in == "" {
+		return fmt.Errorf("missing -in")
+	}
+
+	f, err := os.Open(in)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, vectoryDB, err := openDB(cfgPath)
+	if err != nil {
+		return err
+	}
+	defer vectoryDB.Close()
+
+	c, err := vectoryDB.RestoreCollection(context.Background(), f)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := c.GetConfig()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("restored collection %s\n", cfg.Name)
+
+	return nil
+}
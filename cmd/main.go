@@ -10,15 +10,27 @@ import (
 	"gopkg.in/yaml.v3"
 	"io/ioutil"
 	"log"
+	"os"
+	"strings"
 )
 
 type Config struct {
-	FilesPath  string `yaml:"files_path"`
-	ListenPort int    `yaml:"listen_port"`
+	FilesPath   string `yaml:"files_path"`
+	ListenPort  int    `yaml:"listen_port"`
+	BackupsPath string `yaml:"backups_path"`
 }
 
 // main is invoked when deploying Vectory on the cloud.
+// it serves the api, or runs the command given as the first argument.
 func main() {
+	if len(os.Args) > 1 && !strings.HasPrefix(os.Args[1], "-") {
+		if err := runCommand(os.Args[1], os.Args[2:]); err != nil {
+			log.Fatalf("%s: %v", os.Args[1], err)
+		}
+
+		return
+	}
+
 	var cfgPath string
 
 	flag.StringVar(&cfgPath, "config", "", "config path for Vectory")
@@ -40,7 +52,7 @@ func main() {
 	}
 
 	api := operations.NewVectoryAPI(apiSpec)
-	handlers.InitHandlers(api, vectoryDB)
+	handlers.InitHandlers(api, vectoryDB, cfg.BackupsPath)
 
 	server := restapi.NewServer(api)
 	server.Port = cfg.ListenPort
@@ -53,6 +65,11 @@ func main() {
 	if err != nil {
 		log.Fatal(err)
 	}
+
+	// serve returns once the server is shut down by SIGINT or SIGTERM
+	if err = vectoryDB.Close(); err != nil {
+		log.Fatal(err)
+	}
 }
 
 func readConfig(path string) (*Config, error) {
@@ -61,7 +78,7 @@ func readConfig(path string) (*Config, error) {
 		return nil, err
 	}
 
-	var cfg Config
+	cfg := Config{BackupsPath: "./backups"}
 
 	err = yaml.Unmarshal(b, &cfg)
 	if err != nil {
@@ -0,0 +1,355 @@
+package db
+
+import (
+	"Vectory/db/core/index"
+	"Vectory/db/core/objstore"
+	"Vectory/entities/collection"
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/pkg/errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// backupConfigFile is the first entry of a backup archive, it holds the collection's configuration
+const backupConfigFile = "collection.json"
+
+// BackupName returns the file name of a backup of the collection with name created at t.
+func BackupName(name string, t time.Time) string {
+	return fmt.Sprintf("%s-%s.tar.gz", name, t.UTC().Format("20060102T150405Z"))
+}
+
+// BackupCollection writes a gzipped tar archive of the collection with name to w. the archive holds the
+// collection's configuration and files in a consistent state, writes to the collection are blocked only while
+// its files are copied, and searches are still served.
+func (db *DB) BackupCollection(ctx context.Context, name string, w io.Writer) error {
+	c, err := db.GetCollection(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	return c.backup(w)
+}
+
+func (c *Collection) backup(w io.Writer) error {
+	dir, err := c.copyFiles()
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	cfg, err := json.Marshal(c.config)
+	if err != nil {
+		return err
+	}
+
+	if err = tw.WriteHeader(&tar.Header{Name: backupConfigFile, Mode: 0o644, Size: int64(len(cfg))}); err != nil {
+		return err
+	}
+
+	if _, err = tw.Write(cfg); err != nil {
+		return err
+	}
+
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || !info.Mode().IsRegular() {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		return archiveFile(tw, path, filepath.ToSlash(rel), info)
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed archiving %s", c.filesPath)
+	}
+
+	if err = tw.Close(); err != nil {
+		return err
+	}
+
+	return gw.Close()
+}
+
+// copyFiles copies the files of the collection in a consistent state to a new directory next to the collection's
+// directory and returns it, the vector indexes are paused only while they're copied. the files are copied rather
+// than hard linked since some of them, e.g. the id counter and the DiskANN graph, are modified in place.
+func (c *Collection) copyFiles() (string, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.closed {
+		return "", ErrCollectionClosed
+	}
+
+	// the vector indexes aren't persisted until the bulk import ends
+	if c.bulk {
+		return "", ErrBulkImportRunning
+	}
+
+	indexes := append(make([]index.VectorIndex, 0, 1+len(c.namedIndexes)), c.vectorIndex)
+	for _, idx := range c.namedIndexes {
+		indexes = append(indexes, idx)
+	}
+
+	for i, idx := range indexes {
+		if err := idx.Pause(); err != nil {
+			resume(indexes[:i])
+			return "", errors.Wrap(err, "failed pausing vector index")
+		}
+	}
+	defer resume(indexes)
+
+	dir, err := os.MkdirTemp(filepath.Dir(c.filesPath), fmt.Sprintf(".%s.backup-", c.name))
+	if err != nil {
+		return "", err
+	}
+
+	err = filepath.Walk(c.filesPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil || !info.Mode().IsRegular() {
+			return err
+		}
+
+		rel, err := filepath.Rel(c.filesPath, path)
+		if err != nil || objstore.IsDerivedFile(rel) {
+			return err
+		}
+
+		return copyFile(path, filepath.Join(dir, rel), info)
+	})
+	if err != nil {
+		os.RemoveAll(dir)
+		return "", errors.Wrapf(err, "failed copying %s", c.filesPath)
+	}
+
+	return dir, nil
+}
+
+// copyFile copies the file at src with info to dst.
+func copyFile(src, dst string, info os.FileInfo) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0o750); err != nil {
+		return err
+	}
+
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+
+	if _, err = io.CopyN(out, in, info.Size()); err != nil {
+		out.Close()
+		return err
+	}
+
+	if err = out.Close(); err != nil {
+		return err
+	}
+
+	return os.Chtimes(dst, info.ModTime(), info.ModTime())
+}
+
+// resume resumes the modifications of the paused indexes.
+func resume(indexes []index.VectorIndex) {
+	for _, idx := range indexes {
+		idx.Resume()
+	}
+}
+
+// archiveFile writes the file at path to tw as name.
+func archiveFile(tw *tar.Writer, path, name string, info os.FileInfo) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err = tw.WriteHeader(&tar.Header{Name: name, Mode: int64(info.Mode().Perm()), Size: info.Size(), ModTime: info.ModTime()}); err != nil {
+		return err
+	}
+
+	_, err = io.CopyN(tw, f, info.Size())
+
+	return err
+}
+
+// RestoreCollection creates a collection from an archive written by BackupCollection, under the name it was
+// backed up with. the collection must not exist.
+func (db *DB) RestoreCollection(ctx context.Context, r io.Reader) (*Collection, error) {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %s", ErrValidationFailed, ErrInvalidBackup, err)
+	}
+	defer gr.Close()
+
+	tr := tar.NewReader(gr)
+
+	cfg, err := readBackupConfig(tr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = db.checkRestorable(cfg.Name); err != nil {
+		return nil, err
+	}
+
+	// the files are extracted to a directory of their own before the database is locked, so that a large
+	// archive doesn't block the other collections
+	tmpPath, err := os.MkdirTemp(db.filesPath, fmt.Sprintf(".%s.restore-", cfg.Name))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed creating restore directory of %s", cfg.Name)
+	}
+	defer os.RemoveAll(tmpPath)
+
+	if err = extractArchive(tr, tmpPath); err != nil {
+		return nil, err
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	// the collection may have been created, or the database closed, during the extraction
+	if db.closed {
+		return nil, ErrDatabaseClosed
+	}
+
+	if _, ok := db.collections[cfg.Name]; ok {
+		return nil, ErrCollectionAlreadyExists
+	}
+
+	collectionPath := fmt.Sprintf("%s/%s", db.filesPath, cfg.Name)
+	if err = os.Rename(tmpPath, collectionPath); err != nil {
+		return nil, errors.Wrapf(err, "failed moving restored files of %s", cfg.Name)
+	}
+
+	// removeFiles removes the restored files so that the restore can be retried
+	removeFiles := func() {
+		if removeErr := os.RemoveAll(collectionPath); removeErr != nil {
+			db.logger.WithError(removeErr).Errorf("failed removing the restored files of %s", cfg.Name)
+		}
+	}
+
+	collectionID, err := db.metadataManager.CreateCollection(ctx, cfg)
+	if err != nil {
+		removeFiles()
+		return nil, err
+	}
+
+	c, err := newCollection(collectionID, cfg, db.filesPath)
+	if err != nil {
+		if deleteErr := db.metadataManager.DeleteCollection(ctx, cfg.Name); deleteErr != nil {
+			db.logger.WithError(deleteErr).Errorf("failed deleting the metadata of %s", cfg.Name)
+		}
+
+		removeFiles()
+
+		return nil, errors.Wrapf(err, "failed opening restored collection %s", cfg.Name)
+	}
+
+	db.collections[c.name] = c
+
+	return c, nil
+}
+
+// checkRestorable returns an error if a collection named name can't be restored.
+func (db *DB) checkRestorable(name string) error {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	if db.closed {
+		return ErrDatabaseClosed
+	}
+
+	if _, ok := db.collections[name]; ok {
+		return ErrCollectionAlreadyExists
+	}
+
+	return nil
+}
+
+// readBackupConfig reads and validates the collection's configuration from the first entry of tr.
+func readBackupConfig(tr *tar.Reader) (*collection.Collection, error) {
+	hdr, err := tr.Next()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %s", ErrValidationFailed, ErrInvalidBackup, err)
+	}
+
+	if hdr.Name != backupConfigFile {
+		return nil, fmt.Errorf("%w: %s: first entry is %s instead of %s", ErrValidationFailed, ErrInvalidBackup, hdr.Name, backupConfigFile)
+	}
+
+	var cfg collection.Collection
+	if err = json.NewDecoder(tr).Decode(&cfg); err != nil {
+		return nil, fmt.Errorf("%w: %s: %s", ErrValidationFailed, ErrInvalidBackup, err)
+	}
+
+	if err = collection.Validate(&cfg); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrValidationFailed, err)
+	}
+
+	// the name is the collection's directory
+	if cfg.Name != filepath.Base(cfg.Name) || strings.HasPrefix(cfg.Name, ".") {
+		return nil, fmt.Errorf("%w: %s: collection name %q", ErrValidationFailed, ErrInvalidBackup, cfg.Name)
+	}
+
+	return &cfg, nil
+}
+
+// extractArchive writes the files of tr to dir.
+func extractArchive(tr *tar.Reader, dir string) error {
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("%w: %s: %s", ErrValidationFailed, ErrInvalidBackup, err)
+		}
+
+		name := filepath.Clean(filepath.FromSlash(hdr.Name))
+		if hdr.Typeflag != tar.TypeReg || filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+			return fmt.Errorf("%w: %s: unexpected entry %s", ErrValidationFailed, ErrInvalidBackup, hdr.Name)
+		}
+
+		if err = extractFile(tr, filepath.Join(dir, name), os.FileMode(hdr.Mode).Perm()); err != nil {
+			return errors.Wrapf(err, "failed extracting %s", hdr.Name)
+		}
+	}
+}
+
+// extractFile writes the current entry of tr to path.
+func extractFile(tr *tar.Reader, path string, mode os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+
+	if _, err = io.Copy(f, tr); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,205 @@
+package db
+
+import (
+	"Vectory/entities/collection"
+	"Vectory/entities/distance"
+	"Vectory/entities/index"
+	"Vectory/entities/mappings"
+	"Vectory/entities/objstore"
+	"Vectory/entities/search"
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDB_BackupRestore(t *testing.T) {
+	ctx := context.Background()
+	filesPath, restorePath := "./tmp_backup", "./tmp_restore"
+	defer os.RemoveAll(filesPath)
+	defer os.RemoveAll(restorePath)
+
+	cfg := collection.Collection{
+		Name:        "test_collection",
+		IndexType:   index.Hnsw,
+		DataType:    "text",
+		IndexParams: index.DefaultHnswParams,
+		Mappings:    []mappings.Mapping{{Name: "title", Type: mappings.Text, Indexed: true}},
+		NamedVectors: []index.NamedVector{{Name: "body", IndexType: index.DiskAnn, IndexParams: index.DiskAnnParams{
+			MaxDegree:       32,
+			ListSize:        100,
+			Alpha:           1.2,
+			MemoryIndexSize: 200,
+			DistanceType:    distance.Euclidean,
+		}}},
+	}
+
+	db, err := Open(filesPath)
+	require.NoError(t, err)
+	defer db.Close()
+
+	c, err := db.CreateCollection(ctx, &cfg)
+	require.NoError(t, err)
+
+	size, dim := 500, 32
+	objs := make([]*objstore.Object, 0, size)
+	for i := 0; i < size; i++ {
+		objs = append(objs, &objstore.Object{
+			Key:        fmt.Sprintf("doc-%d", i),
+			Properties: map[string]interface{}{"title": fmt.Sprintf("title %d", i)},
+			Vector:     randomVector(dim),
+			Vectors:    map[string][]float32{"body": randomVector(16)},
+		})
+	}
+
+	require.NoError(t, c.InsertBatch(ctx, objs))
+	require.NoError(t, c.Delete(objs[0].Id))
+
+	var archive bytes.Buffer
+	require.NoError(t, db.BackupCollection(ctx, cfg.Name, &archive))
+
+	// changes after the backup are not part of it
+	require.NoError(t, c.Delete(objs[1].Id))
+
+	t.Run("restore", func(t *testing.T) {
+		restoreDB, err := Open(restorePath)
+		require.NoError(t, err)
+		defer restoreDB.Close()
+
+		// a restore that fails to open the collection leaves nothing behind and can be retried
+		_, err = restoreDB.RestoreCollection(ctx, bytes.NewReader(corruptArchive(t, archive.Bytes(), "index/")))
+		require.ErrorContains(t, err, "failed opening restored collection")
+		require.NoDirExists(t, fmt.Sprintf("%s/%s", restorePath, cfg.Name))
+
+		restored, err := restoreDB.RestoreCollection(ctx, bytes.NewReader(archive.Bytes()))
+		require.NoError(t, err)
+
+		restoredSize, err := restored.GetSize()
+		require.NoError(t, err)
+		require.Equal(t, size-1, restoredSize)
+
+		res, err := restored.GetByKeys([]string{"doc-0", "doc-1"})
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		require.Equal(t, objs[1].Id, res[0].Id)
+
+		for _, obj := range objs[1:10] {
+			nearest, err := restored.SemanticSearch(ctx, &objstore.Object{Vector: obj.Vector}, search.NewOptions(1), nil, nil)
+			require.NoError(t, err)
+			require.Equal(t, obj.Id, nearest.Objects[0].Id)
+
+			opts := search.NewOptions(1)
+			opts.Vector = "body"
+			nearest, err = restored.SemanticSearch(ctx, &objstore.Object{Vectors: obj.Vectors}, opts, nil, nil)
+			require.NoError(t, err)
+			require.Equal(t, obj.Id, nearest.Objects[0].Id)
+		}
+
+		_, err = restoreDB.RestoreCollection(ctx, bytes.NewReader(archive.Bytes()))
+		require.ErrorIs(t, err, ErrCollectionAlreadyExists)
+
+		// inserting continues after the restored ids
+		obj := &objstore.Object{Properties: map[string]interface{}{"title": "new"}, Vector: randomVector(dim), Vectors: map[string][]float32{"body": randomVector(16)}}
+		require.NoError(t, restored.Insert(ctx, obj))
+		require.Equal(t, objs[size-1].Id+1, obj.Id)
+	})
+
+	t.Run("database isn't locked during extraction", func(t *testing.T) {
+		lockPath := "./tmp_restore_lock"
+		defer os.RemoveAll(lockPath)
+
+		restoreDB, err := Open(lockPath)
+		require.NoError(t, err)
+		defer restoreDB.Close()
+
+		pr, pw := io.Pipe()
+		defer pw.Close()
+
+		restoreErr := make(chan error, 1)
+		go func() {
+			_, err := restoreDB.RestoreCollection(ctx, pr)
+			restoreErr <- err
+		}()
+
+		// the first half holds the configuration, the restore then waits for the rest of the files
+		half := archive.Len() / 2
+		_, err = pw.Write(archive.Bytes()[:half])
+		require.NoError(t, err)
+
+		created := make(chan error, 1)
+		go func() {
+			_, err := restoreDB.CreateCollection(ctx, &cfg)
+			created <- err
+		}()
+
+		select {
+		case err = <-created:
+			require.NoError(t, err)
+		case <-time.After(10 * time.Second):
+			t.Fatal("collection creation is blocked by the restore")
+		}
+
+		_, err = pw.Write(archive.Bytes()[half:])
+		require.NoError(t, err)
+		require.NoError(t, pw.Close())
+
+		// the collection created meanwhile is kept
+		require.ErrorIs(t, <-restoreErr, ErrCollectionAlreadyExists)
+
+		entries, err := os.ReadDir(lockPath)
+		require.NoError(t, err)
+		for _, e := range entries {
+			require.False(t, strings.HasPrefix(e.Name(), "."), e.Name())
+		}
+	})
+
+	t.Run("invalid archive", func(t *testing.T) {
+		_, err := db.RestoreCollection(ctx, strings.NewReader("not an archive"))
+		require.ErrorIs(t, err, ErrValidationFailed)
+
+		err = db.BackupCollection(ctx, "missing", &bytes.Buffer{})
+		require.ErrorIs(t, err, ErrValidationFailed)
+	})
+}
+
+// corruptArchive returns a copy of the backup archive whose files with prefix hold garbage
+func corruptArchive(t *testing.T, archive []byte, prefix string) []byte {
+	gr, err := gzip.NewReader(bytes.NewReader(archive))
+	require.NoError(t, err)
+
+	var corrupted bytes.Buffer
+	gw := gzip.NewWriter(&corrupted)
+	tr, tw := tar.NewReader(gr), tar.NewWriter(gw)
+
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		data, err := io.ReadAll(tr)
+		require.NoError(t, err)
+
+		if strings.HasPrefix(hdr.Name, prefix) {
+			data = bytes.Repeat([]byte("garbage"), 10)
+			hdr.Size = int64(len(data))
+		}
+
+		require.NoError(t, tw.WriteHeader(hdr))
+		_, err = tw.Write(data)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, tw.Close())
+	require.NoError(t, gw.Close())
+
+	return corrupted.Bytes()
+}
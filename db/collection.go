@@ -2,17 +2,21 @@ package db
 
 import (
 	"Vectory/db/core/index"
+	"Vectory/db/core/index/disk_ann"
+	"Vectory/db/core/index/filtering"
 	"Vectory/db/core/index/hnsw"
+	"Vectory/db/core/index/inverted"
 	"Vectory/db/core/objstore"
 	"Vectory/db/embeddings"
 	"Vectory/entities/collection"
-	"Vectory/entities/embeddings/hugging_face/text2vec"
 	indexentities "Vectory/entities/index"
+	"Vectory/entities/mappings"
 	objstoreentities "Vectory/entities/objstore"
 	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/alitto/pond"
+	"github.com/pkg/errors"
 	"runtime"
 	"sync"
 )
@@ -20,19 +24,25 @@ import (
 var _ CRUD = &Collection{}
 
 type Collection struct {
-	mu          sync.RWMutex
-	id          int
-	name        string
-	dataType    string
-	stores      *objstore.Stores
-	vectorIndex index.VectorIndex
-	idCounter   *IdCounter
-	logger      any
-	embedder    embeddings.Embedder
-	wp          *pond.WorkerPool
-	filesPath   string
-	config      collection.Collection
-	closed      bool
+	mu           sync.RWMutex
+	id           int
+	name         string
+	dataType     string
+	stores       *objstore.Stores
+	vectorIndex  index.VectorIndex
+	namedIndexes map[string]index.VectorIndex // indexes of the named vectors by their names
+	keywordIndex *inverted.Index              // nil when no property is indexed for keyword search
+	filterIndex  *filtering.Index             // nil when no property is filterable
+	idCounter    *IdCounter
+	journal      *journal
+	logger       any
+	embedder     embeddings.Embedder
+	wp           *pond.WorkerPool
+	filesPath    string
+	config       collection.Collection
+	closed       bool
+	failed       error // set when an operation couldn't be rolled back, writes fail with it until the collection is reopened
+	bulk         bool  // set while a bulk import builds the vector indexes, other writes are rejected
 }
 
 func newCollection(id int, cfg *collection.Collection, filesPath string) (*Collection, error) {
@@ -44,6 +54,10 @@ func newCollection(id int, cfg *collection.Collection, filesPath string) (*Colle
 		config:   *cfg,
 	}
 
+	if c.config.VectorType == "" {
+		c.config.VectorType = collection.FloatVectorType
+	}
+
 	c.filesPath = fmt.Sprintf("%s/%s", filesPath, c.name)
 
 	os, err := objstore.NewStores(c.filesPath)
@@ -60,38 +74,75 @@ func newCollection(id int, cfg *collection.Collection, filesPath string) (*Colle
 
 	c.idCounter = counter
 
-	switch cfg.IndexType {
-	case indexentities.Hnsw:
-		var params indexentities.HnswParams
+	if c.vectorIndex, err = newVectorIndex(cfg.IndexType, cfg.IndexParams, IndexPath(filesPath, c.name, ""), os); err != nil {
+		return nil, err
+	}
 
-		b, _ := json.Marshal(cfg.IndexParams) // validated in wrapper function
-		_ = json.Unmarshal(b, &params)
+	c.namedIndexes = make(map[string]index.VectorIndex, len(cfg.NamedVectors))
 
-		idx, err := hnsw.NewHnsw(params, c.filesPath, os)
+	for _, v := range cfg.NamedVectors {
+		idx, err := newVectorIndex(v.IndexType, v.IndexParams, IndexPath(filesPath, c.name, v.Name), os.Named(v.Name))
 		if err != nil {
 			return nil, err
 		}
 
-		c.vectorIndex = idx
-	default:
-		return nil, ErrUnknownIndexType
+		c.namedIndexes[v.Name] = idx
 	}
 
-	switch cfg.EmbedderType {
-	case text2vec.Text2VecHuggingFace:
-		var config text2vec.Config
+	if c.journal, err = openJournal(fmt.Sprintf("%s/%s", c.filesPath, journalDir)); err != nil {
+		return nil, err
+	}
 
-		b, _ := json.Marshal(cfg.EmbedderConfig) // validated in wrapper function
-		_ = json.Unmarshal(b, &config)
+	if err = c.recover(); err != nil {
+		return nil, errors.Wrapf(err, "failed recovering collection %s", c.name)
+	}
 
-		c.embedder = embeddings.NewText2vecEmbedder(&config)
-	case embeddings.FakeEmbedder: // for test purposes
-		c.embedder = embeddings.NewFakeEmbedder()
+	if c.hasIndexedMappings() {
+		c.keywordIndex = inverted.NewIndex()
+	}
+
+	if c.hasFilterableMappings() {
+		c.filterIndex = filtering.NewIndex()
+	}
+
+	if c.keywordIndex != nil || c.filterIndex != nil {
+		if err = c.rebuildPropertyIndexes(); err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.EmbedderType != "" {
+		if c.embedder, err = embeddings.New(cfg.EmbedderType, cfg.EmbedderConfig); err != nil {
+			return nil, errors.Wrapf(err, "failed creating embedder of collection %s", c.name)
+		}
 	}
 
 	return &c, nil
 }
 
+// newVectorIndex creates or restores an index of indexType in filesPath, the vectors of hnsw indexes are
+// restored from store.
+func newVectorIndex(indexType string, indexParams interface{}, filesPath string, store *objstore.Stores) (index.VectorIndex, error) {
+	switch indexType {
+	case indexentities.Hnsw:
+		var params indexentities.HnswParams
+
+		b, _ := json.Marshal(indexParams) // validated in wrapper function
+		_ = json.Unmarshal(b, &params)
+
+		return hnsw.NewHnsw(params, filesPath, store)
+	case indexentities.DiskAnn:
+		var params indexentities.DiskAnnParams
+
+		b, _ := json.Marshal(indexParams) // validated in wrapper function
+		_ = json.Unmarshal(b, &params)
+
+		return disk_ann.NewDiskAnn(params, filesPath)
+	default:
+		return nil, ErrUnknownIndexType
+	}
+}
+
 // GetConfig returns collection's configurations.
 func (c *Collection) GetConfig() (*collection.Collection, error) {
 	if c.closed {
@@ -123,6 +174,20 @@ func (c *Collection) Close() error {
 		return err
 	}
 
+	if err := c.vectorIndex.Close(); err != nil {
+		return err
+	}
+
+	for _, idx := range c.namedIndexes {
+		if err := idx.Close(); err != nil {
+			return err
+		}
+	}
+
+	if err := c.journal.close(); err != nil {
+		return err
+	}
+
 	c.closed = true
 
 	return nil
@@ -133,23 +198,106 @@ func (c *Collection) IsClosed() bool {
 	return c.closed
 }
 
-// TODO: currently checking naively the mapping keys but in future check types as well
-func (c *Collection) validateObjectsMappings(objs []*objstoreentities.Object) error {
+// validateObjects checks every object of objs with validate, and reports the number of the first invalid object.
+func validateObjects(objs []*objstoreentities.Object, validate func(obj *objstoreentities.Object) error) error {
 	for i, obj := range objs {
-		if len(obj.Properties) > len(c.config.Mappings) {
-			return fmt.Errorf("length mismatch of object number %d properties and collection's mappings", i)
+		if err := validate(obj); err != nil {
+			return fmt.Errorf("object number %d: %w", i, err)
 		}
+	}
 
-		for _, m := range c.config.Mappings {
-			if _, ok := obj.Properties[m]; !ok {
-				return fmt.Errorf("object number %d does not have property %s", i, m)
-			}
+	return nil
+}
+
+// validateObjectsMappings checks that objs have exactly the properties of the collection's mappings with matching types.
+func (c *Collection) validateObjectsMappings(objs []*objstoreentities.Object) error {
+	return validateObjects(objs, c.validateObjectMappings)
+}
+
+// validateObjectMappings checks that obj has exactly the properties of the collection's mappings with matching types.
+func (c *Collection) validateObjectMappings(obj *objstoreentities.Object) error {
+	if len(obj.Properties) > len(c.config.Mappings) {
+		return fmt.Errorf("%w: length mismatch of object properties and collection's mappings", ErrValidationFailed)
+	}
+
+	for _, m := range c.config.Mappings {
+		v, ok := obj.Properties[m.Name]
+		if !ok {
+			return fmt.Errorf("%w: object does not have property %s", ErrValidationFailed, m.Name)
+		}
+
+		if v == nil { // null values are allowed for every type
+			continue
+		}
+
+		if err := mappings.ValidateValue(m, v); err != nil {
+			return fmt.Errorf("%w: %s", ErrValidationFailed, err)
 		}
 	}
 
 	return nil
 }
 
+// validateObjectsKeys checks that the keys of objs are unique both among them and in the collection.
+func (c *Collection) validateObjectsKeys(objs []*objstoreentities.Object) error {
+	keys := make(map[string]struct{})
+
+	return validateObjects(objs, func(obj *objstoreentities.Object) error {
+		return c.validateObjectKey(obj, keys)
+	})
+}
+
+// validateObjectKey checks that the key of obj is unique both among keys and in the collection, and adds it to keys.
+func (c *Collection) validateObjectKey(obj *objstoreentities.Object, keys map[string]struct{}) error {
+	if obj.Key == "" {
+		return nil
+	}
+
+	if len(obj.Key) > objstoreentities.MaxKeyLength {
+		return fmt.Errorf("%w: %s", ErrValidationFailed, ErrKeyTooLong)
+	}
+
+	if _, ok := keys[obj.Key]; ok {
+		return fmt.Errorf("%w: %s %s", ErrValidationFailed, ErrKeyAlreadyExists, obj.Key)
+	}
+
+	_, found, err := c.stores.GetId(obj.Key)
+	if err != nil {
+		return errors.Wrapf(err, "failed getting the id of %s from keys store", obj.Key)
+	}
+
+	if found {
+		return fmt.Errorf("%w: %s %s", ErrValidationFailed, ErrKeyAlreadyExists, obj.Key)
+	}
+
+	keys[obj.Key] = struct{}{}
+
+	return nil
+}
+
+// validateObjectsVectors checks that the vectors of a binary vectors collection hold only 0 and 1 values,
+// and marks them as binary so they are stored as packed bits.
+func (c *Collection) validateObjectsVectors(objs []*objstoreentities.Object) error {
+	return validateObjects(objs, c.validateObjectVector)
+}
+
+// validateObjectVector checks the vector of obj the same way as validateObjectsVectors.
+func (c *Collection) validateObjectVector(obj *objstoreentities.Object) error {
+	if c.config.VectorType != collection.BinaryVectorType {
+		return nil
+	}
+
+	for _, x := range obj.Vector {
+		if x != 0 && x != 1 {
+			return fmt.Errorf("%w: %s", ErrValidationFailed, ErrNotBinaryVector)
+		}
+	}
+
+	obj.Binary = true
+
+	return nil
+}
+
 func (c *Collection) embedObjectsIfNeeded(ctx context.Context, objs []*objstoreentities.Object) error {
 	if c.embedder == nil {
 		for _, o := range objs {
@@ -160,18 +308,42 @@ func (c *Collection) embedObjectsIfNeeded(ctx context.Context, objs []*objstoree
 		}
 	} else {
 		filtered := make([]*objstoreentities.Object, 0, len(objs))
+		inputs := make([]*objstoreentities.Object, 0, len(objs))
 		for _, o := range objs {
 			if o.Vector != nil {
 				continue
 			}
 
 			filtered = append(filtered, o)
+			inputs = append(inputs, &objstoreentities.Object{Properties: c.embeddedProperties(o.Properties)})
 		}
 
-		if err := c.embedder.Embed(ctx, filtered); err != nil {
+		if err := c.embedder.Embed(ctx, inputs); err != nil {
 			return err
 		}
+
+		for i, o := range filtered {
+			o.Vector = inputs[i].Vector
+		}
 	}
 
 	return nil
 }
+
+// embeddedProperties returns the properties that are part of the embedder's input, which are all properties
+// except the ones whose mappings are not marked for embedding.
+func (c *Collection) embeddedProperties(properties map[string]interface{}) map[string]interface{} {
+	embedded := make(map[string]interface{}, len(properties))
+
+	for k, v := range properties {
+		embedded[k] = v
+	}
+
+	for _, m := range c.config.Mappings {
+		if !m.Embed {
+			delete(embedded, m.Name)
+		}
+	}
+
+	return embedded
+}
@@ -4,6 +4,13 @@ import "github.com/pkg/errors"
 
 // Delete deletes an object with objId from the collection.
 func (c *Collection) Delete(objId uint64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.writable(); err != nil {
+		return err
+	}
+
 	_, found, err := c.stores.GetObject(objId)
 	if err != nil {
 		return errors.Wrapf(err, "failed getting %d from object store", objId)
@@ -14,18 +21,55 @@ func (c *Collection) Delete(objId uint64) error {
 		return nil
 	}
 
+	if err = c.journal.begin([]uint64{objId}); err != nil {
+		return err
+	}
+
+	if err = c.remove([]uint64{objId}); err != nil {
+		return c.abort(err)
+	}
+
+	if err = c.journal.commit(); err != nil {
+		return c.abort(err)
+	}
+
+	return nil
+}
+
+// DeleteByKey deletes the object with key from the collection.
+func (c *Collection) DeleteByKey(key string) error {
+	id, found, err := c.stores.GetId(key)
+	if err != nil {
+		return errors.Wrapf(err, "failed getting the id of %s from keys store", key)
+	}
+
+	if !found {
+		// nothing to do
+		return nil
+	}
+
+	return c.Delete(id)
+}
+
+// Compact removes the deleted objects from the vector indexes and reclaims the space of deleted and overwritten
+// objects in the stores. writes to the collection are blocked while it's compacted.
+func (c *Collection) Compact() error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	err = c.stores.DeleteObject(objId)
-	if err != nil {
-		return errors.Wrapf(err, "failed deleting %d from object store", objId)
+	if err := c.writable(); err != nil {
+		return err
 	}
 
-	err = c.vectorIndex.Delete(objId)
-	if err != nil {
-		return errors.Wrapf(err, "failed deleting %d from vector index", objId)
+	if err := c.vectorIndex.Compact(); err != nil {
+		return errors.Wrap(err, "failed compacting vector index")
+	}
+
+	for name, idx := range c.namedIndexes {
+		if err := idx.Compact(); err != nil {
+			return errors.Wrapf(err, "failed compacting vector index of %s", name)
+		}
 	}
 
-	return c.vectorIndex.Flush()
+	return errors.Wrap(c.stores.Compact(), "failed compacting stores")
 }
@@ -1 +1,99 @@
 package db
+
+import (
+	"Vectory/entities/collection"
+	"Vectory/entities/distance"
+	"Vectory/entities/index"
+	"Vectory/entities/mappings"
+	"Vectory/entities/objstore"
+	"Vectory/entities/search"
+	"context"
+	"github.com/stretchr/testify/require"
+	"os"
+	"testing"
+)
+
+func TestCollection_Compact(t *testing.T) {
+	ctx := context.Background()
+	filesPath := "./tmp_compact"
+	defer os.RemoveAll(filesPath)
+
+	cfg := collection.Collection{
+		Name:        "test_collection",
+		IndexType:   index.Hnsw,
+		DataType:    "text",
+		IndexParams: index.DefaultHnswParams,
+		Mappings:    []mappings.Mapping{{Name: "title", Type: mappings.Text}},
+		NamedVectors: []index.NamedVector{{Name: "body", IndexType: index.DiskAnn, IndexParams: index.DiskAnnParams{
+			MaxDegree:       32,
+			ListSize:        100,
+			Alpha:           1.2,
+			MemoryIndexSize: 50,
+			DistanceType:    distance.Euclidean,
+		}}},
+	}
+
+	db, err := Open(filesPath)
+	require.NoError(t, err)
+
+	c, err := db.CreateCollection(ctx, &cfg)
+	require.NoError(t, err)
+
+	size, dim := 300, 32
+	objs := make([]*objstore.Object, 0, size)
+	for i := 0; i < size; i++ {
+		objs = append(objs, &objstore.Object{
+			Properties: map[string]interface{}{"title": "blah"},
+			Vector:     randomVector(dim),
+			Vectors:    map[string][]float32{"body": randomVector(16)},
+		})
+	}
+
+	require.NoError(t, c.InsertBatch(ctx, objs))
+
+	for _, obj := range objs[:size/3] {
+		require.NoError(t, c.Delete(obj.Id))
+	}
+
+	require.NoError(t, c.Compact())
+
+	// the vectors of the deleted objects are removed once no index traverses them
+	ids, err := c.stores.VectorsIds()
+	require.NoError(t, err)
+	require.Len(t, ids, size-size/3)
+	require.Len(t, c.namedIndexes["body"].Ids(), size-size/3)
+
+	requireFound := func(t *testing.T, c *Collection) {
+		for _, obj := range objs[size/3 : size/3+10] {
+			res, err := c.SemanticSearch(ctx, &objstore.Object{Vector: obj.Vector}, search.NewOptions(1), nil, nil)
+			require.NoError(t, err)
+			require.Equal(t, obj.Id, res.Objects[0].Id)
+
+			opts := search.NewOptions(1)
+			opts.Vector = "body"
+			res, err = c.SemanticSearch(ctx, &objstore.Object{Vectors: obj.Vectors}, opts, nil, nil)
+			require.NoError(t, err)
+			require.Equal(t, obj.Id, res.Objects[0].Id)
+		}
+	}
+
+	requireFound(t, c)
+
+	t.Run("reopen", func(t *testing.T) {
+		require.NoError(t, db.Close())
+
+		db, err = Open(filesPath)
+		require.NoError(t, err)
+		defer db.Close()
+
+		c, err := db.GetCollection(ctx, cfg.Name)
+		require.NoError(t, err)
+
+		size, err := c.GetSize()
+		require.NoError(t, err)
+		require.Equal(t, len(objs)-len(objs)/3, size)
+		require.Len(t, c.vectorIndex.Ids(), size)
+
+		requireFound(t, c)
+	})
+}
@@ -0,0 +1,111 @@
+package db
+
+import (
+	"Vectory/entities/filters"
+	objstoreentities "Vectory/entities/objstore"
+	"Vectory/entities/search"
+	"fmt"
+)
+
+const (
+	// preFilterSelectivity is the fraction of matching objects under which a filter is applied before
+	// traversing the index with an allow-list, and above which results are filtered after the search.
+	preFilterSelectivity = 0.1
+
+	// postFilterOverFetch is the factor by which k is multiplied for each post-filtering search round.
+	postFilterOverFetch = 4
+)
+
+// filteredSearch returns the k-nn of vector whose properties match filter. the objects matching filter are
+// found by the filter index, which holds the filterable properties compared by valid filters.
+func (c *Collection) filteredSearch(vector []float32, opts search.Options, filter *filters.Filter) ([]objstoreentities.ObjectWithDistance, error) {
+	allowList := c.filterIndex.Match(filter)
+
+	if allowList.Len() == 0 {
+		return []objstoreentities.ObjectWithDistance{}, nil
+	}
+
+	if float64(allowList.Len()) <= preFilterSelectivity*float64(c.filterIndex.Len()) {
+		return c.fetchObjects(c.indexOf(opts.Vector).SearchWithAllowList(vector, opts, allowList), opts.K, nil)
+	}
+
+	return c.postFilteredSearch(vector, opts, filter)
+}
+
+// postFilteredSearch searches for more than k neighbors and drops the ones not matching filter.
+// the number of fetched neighbors grows until k objects match, the whole collection was searched
+// or no more neighbors are within the maximum distance.
+func (c *Collection) postFilteredSearch(vector []float32, opts search.Options, filter *filters.Filter) ([]objstoreentities.ObjectWithDistance, error) {
+	k := opts.K
+	fetch := k * postFilterOverFetch
+
+	for {
+		opts.K = fetch
+		results := c.indexOf(opts.Vector).Search(vector, opts)
+
+		objs, err := c.fetchObjects(results, k, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(objs) == k || len(results) < fetch || fetch >= c.stores.Size() {
+			return objs, nil
+		}
+
+		fetch *= postFilterOverFetch
+	}
+}
+
+// hasFilterableMappings reports whether any property of the collection can be used in search filters.
+func (c *Collection) hasFilterableMappings() bool {
+	for _, m := range c.config.Mappings {
+		if m.Filterable {
+			return true
+		}
+	}
+
+	return false
+}
+
+// indexFilterable adds the filterable properties of obj to the filter index, replacing its previous properties.
+func (c *Collection) indexFilterable(obj *objstoreentities.Object) {
+	if c.filterIndex == nil {
+		return
+	}
+
+	properties := make(map[string]interface{}, len(c.config.Mappings))
+	for _, m := range c.config.Mappings {
+		if v, ok := obj.Properties[m.Name]; ok && m.Filterable {
+			properties[m.Name] = v
+		}
+	}
+
+	c.filterIndex.Add(obj.Id, properties)
+}
+
+// validateFilterMappings checks that every property compared by filter is a filterable property of the collection.
+func (c *Collection) validateFilterMappings(filter *filters.Filter) error {
+	for _, o := range filter.Operands {
+		if err := c.validateFilterMappings(o); err != nil {
+			return err
+		}
+	}
+
+	if filter.Property == "" {
+		return nil
+	}
+
+	for _, m := range c.config.Mappings {
+		if m.Name != filter.Property {
+			continue
+		}
+
+		if !m.Filterable {
+			return fmt.Errorf("%w: property %s is not filterable", ErrValidationFailed, m.Name)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("%w: property %s is not in the collection's mappings", ErrValidationFailed, filter.Property)
+}
@@ -1,9 +1,13 @@
 package db
 
 import (
+	"Vectory/db/core/index/utils"
 	"Vectory/entities/collection"
+	"Vectory/entities/filters"
 	objstoreentities "Vectory/entities/objstore"
+	"Vectory/entities/search"
 	"context"
+	"fmt"
 	"github.com/pkg/errors"
 )
 
@@ -33,8 +37,8 @@ func (c *Collection) Get(objIds []uint64) ([]objstoreentities.Object, error) {
 	return objects, nil
 }
 
-// SemanticSearch returns the approximate k-nn of obj.
-func (c *Collection) SemanticSearch(ctx context.Context, obj *objstoreentities.Object, k int) (*collection.SemanticSearchResult, error) {
+// GetByKeys returns the objects with keys from the collection.
+func (c *Collection) GetByKeys(keys []string) ([]objstoreentities.Object, error) {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
@@ -42,36 +46,175 @@ func (c *Collection) SemanticSearch(ctx context.Context, obj *objstoreentities.O
 		return nil, ErrCollectionClosed
 	}
 
-	if err := c.embedObjectsIfNeeded(ctx, []*objstoreentities.Object{obj}); err != nil {
+	objects := make([]objstoreentities.Object, 0, len(keys))
+	for _, key := range keys {
+		id, found, err := c.stores.GetId(key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed getting the id of %s from keys store", key)
+		}
+
+		if !found {
+			continue
+		}
+
+		obj, found, err := c.stores.GetObject(id)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed getting %d from object store", id)
+		}
+
+		if !found {
+			continue
+		}
+
+		objects = append(objects, *obj)
+	}
+
+	return objects, nil
+}
+
+// SemanticSearch returns the approximate k-nn of obj, the number of neighbors, the searched vector and how they
+// are searched and returned are set by opts.
+// when filter is not nil, only objects whose properties match it are returned.
+// when hybrid is not nil, the k-nn are fused with the BM25 keyword results of the indexed properties.
+func (c *Collection) SemanticSearch(ctx context.Context, obj *objstoreentities.Object, opts search.Options, filter *filters.Filter, hybrid *collection.HybridParams) (*collection.SemanticSearchResult, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.closed {
+		return nil, ErrCollectionClosed
+	}
+
+	if err := search.Validate(&opts); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrValidationFailed, err)
+	}
+
+	if filter != nil {
+		if err := filters.Validate(filter); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrValidationFailed, err)
+		}
+
+		if err := c.validateFilterMappings(filter); err != nil {
+			return nil, err
+		}
+	}
+
+	if hybrid != nil {
+		if err := c.validateHybridParams(hybrid); err != nil {
+			return nil, err
+		}
+	}
+
+	vector, err := c.queryVector(ctx, obj, opts.Vector)
+	if err != nil {
 		return nil, err
 	}
 
-	results := c.vectorIndex.Search(obj.Vector, k)
-	ids := make([]uint64, 0, k)
+	var objs []objstoreentities.ObjectWithDistance
 
-	for _, e := range results {
-		ids = append(ids, e.Id)
+	switch {
+	case hybrid != nil:
+		objs, err = c.hybridSearch(obj, vector, opts, filter, hybrid)
+	case filter == nil:
+		objs, err = c.search(vector, opts)
+	default:
+		objs, err = c.filteredSearch(vector, opts, filter)
 	}
 
-	objs, err := c.stores.GetObjects(ids)
 	if err != nil {
 		return nil, err
 	}
 
-	res := collection.SemanticSearchResult{
-		Hits: len(results),
+	for i := range objs {
+		if !opts.IncludeVector {
+			objs[i].Vector = nil
+			objs[i].Vectors = nil
+		}
+
+		if !opts.IncludeDistance {
+			objs[i].Distance = 0
+		}
 	}
 
-	resObjs := make([]objstoreentities.ObjectWithDistance, 0, len(results))
-	for i := 0; i < len(results); i++ {
-		resObjs = append(resObjs, objstoreentities.ObjectWithDistance{
-			Id:         objs[i].Id,
-			Properties: objs[i].Properties,
-			Distance:   results[i].Distance,
-		})
+	return &collection.SemanticSearchResult{
+		Hits:    len(objs),
+		Objects: objs,
+	}, nil
+}
+
+// RangeSearch returns up to limit objects within radius of obj sorted by their distance,
+// search.DefaultRangeLimit objects when limit is 0. vector is the named vector to search, the objects' vector
+// when empty.
+func (c *Collection) RangeSearch(ctx context.Context, obj *objstoreentities.Object, radius float32, limit int, vector string) (*collection.SemanticSearchResult, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.closed {
+		return nil, ErrCollectionClosed
 	}
 
-	res.Objects = resObjs
+	if err := search.ValidateRangeLimit(limit); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrValidationFailed, err)
+	}
+
+	if limit == 0 {
+		limit = search.DefaultRangeLimit
+	}
+
+	q, err := c.queryVector(ctx, obj, vector)
+	if err != nil {
+		return nil, err
+	}
+
+	results := c.indexOf(vector).RangeSearch(q, radius, limit)
+
+	objs, err := c.fetchObjects(results, limit, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range objs {
+		objs[i].Vector = nil
+		objs[i].Vectors = nil
+	}
+
+	return &collection.SemanticSearchResult{
+		Hits:    len(objs),
+		Objects: objs,
+	}, nil
+}
+
+// search returns the k-nn of vector in the index of the named vector of opts along with their properties.
+func (c *Collection) search(vector []float32, opts search.Options) ([]objstoreentities.ObjectWithDistance, error) {
+	return c.fetchObjects(c.indexOf(opts.Vector).Search(vector, opts), opts.K, nil)
+}
+
+// fetchObjects returns the objects of results, skipping the ones that don't match filter, up to k objects.
+func (c *Collection) fetchObjects(results []utils.Element, k int, filter *filters.Filter) ([]objstoreentities.ObjectWithDistance, error) {
+	objs := make([]objstoreentities.ObjectWithDistance, 0, len(results))
+
+	for _, e := range results {
+		if len(objs) == k {
+			break
+		}
+
+		obj, found, err := c.stores.GetObject(e.Id)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed getting %d from object store", e.Id)
+		}
+
+		if !found || (filter != nil && !filter.Match(obj.Properties)) {
+			continue
+		}
+
+		objs = append(objs, objstoreentities.ObjectWithDistance{
+			Id:         obj.Id,
+			Key:        obj.Key,
+			Properties: obj.Properties,
+			Vector:     obj.Vector,
+			Vectors:    obj.Vectors,
+			Distance:   e.Distance,
+		})
+	}
 
-	return &res, nil
+	return objs, nil
 }
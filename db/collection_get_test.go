@@ -0,0 +1,341 @@
+package db
+
+import (
+	"Vectory/entities/collection"
+	"Vectory/entities/distance"
+	"Vectory/entities/filters"
+	"Vectory/entities/index"
+	"Vectory/entities/mappings"
+	"Vectory/entities/objstore"
+	"Vectory/entities/search"
+	"context"
+	"github.com/stretchr/testify/require"
+	"math/rand"
+	"os"
+	"testing"
+)
+
+func TestCollection_SemanticSearchWithFilter(t *testing.T) {
+	ctx := context.Background()
+	filesPath := "./tmp_filter"
+
+	db, err := Open(filesPath)
+	require.NoError(t, err)
+	defer os.RemoveAll(filesPath)
+
+	for _, tc := range []struct {
+		indexType   string
+		indexParams interface{}
+	}{
+		{indexType: index.Hnsw, indexParams: index.DefaultHnswParams},
+		{indexType: index.DiskAnn, indexParams: index.DiskAnnParams{
+			MaxDegree:       32,
+			ListSize:        100,
+			Alpha:           1.2,
+			MemoryIndexSize: 500,
+			DistanceType:    distance.Euclidean,
+		}},
+	} {
+		t.Run(tc.indexType, func(t *testing.T) {
+			c, err := db.CreateCollection(ctx, &collection.Collection{
+				Name:        "test_collection_" + tc.indexType,
+				IndexType:   tc.indexType,
+				DataType:    "text",
+				IndexParams: tc.indexParams,
+				Mappings: []mappings.Mapping{
+					{Name: "country", Type: mappings.Text, Filterable: true},
+					{Name: "price", Type: mappings.Int, Filterable: true},
+					{Name: "description", Type: mappings.Text},
+				},
+			})
+			require.NoError(t, err)
+
+			countries := []string{"Italy", "France", "Spain", "Portugal", "Greece"}
+			size := 2000
+
+			objs := make([]*objstore.Object, 0, size)
+			for i := 0; i < size; i++ {
+				objs = append(objs, &objstore.Object{
+					Properties: map[string]interface{}{
+						"country":     countries[i%len(countries)],
+						"price":       i % 100,
+						"description": "blah",
+					},
+					Vector: randomVector(32),
+				})
+			}
+			require.NoError(t, c.InsertBatch(ctx, objs))
+
+			searchFiltered := func(t *testing.T, k int, filter *filters.Filter) []objstore.ObjectWithDistance {
+				res, err := c.SemanticSearch(ctx, &objstore.Object{Vector: randomVector(32)}, search.NewOptions(k), filter, nil)
+				require.NoError(t, err)
+
+				for _, o := range res.Objects {
+					require.True(t, filter.Match(o.Properties))
+				}
+
+				return res.Objects
+			}
+
+			t.Run("post-filtering", func(t *testing.T) {
+				res := searchFiltered(t, 10, &filters.Filter{Operator: filters.Or, Operands: []*filters.Filter{
+					{Operator: filters.Equal, Property: "country", Value: "Italy"},
+					{Operator: filters.In, Property: "country", Value: []interface{}{"France", "Spain"}},
+				}})
+				require.Len(t, res, 10)
+			})
+
+			t.Run("pre-filtering", func(t *testing.T) {
+				res := searchFiltered(t, 10, &filters.Filter{Operator: filters.And, Operands: []*filters.Filter{
+					{Operator: filters.Equal, Property: "country", Value: "Italy"},
+					{Operator: filters.LessThan, Property: "price", Value: 10},
+				}})
+				require.Len(t, res, 10)
+			})
+
+			t.Run("less matches than k", func(t *testing.T) {
+				res := searchFiltered(t, 50, &filters.Filter{Operator: filters.Equal, Property: "price", Value: 0})
+				require.Len(t, res, size/100)
+			})
+
+			t.Run("no matches", func(t *testing.T) {
+				res := searchFiltered(t, 10, &filters.Filter{Operator: filters.Not, Operands: []*filters.Filter{
+					{Operator: filters.GreaterThanOrEqual, Property: "price", Value: 0},
+				}})
+				require.Empty(t, res)
+			})
+
+			t.Run("filter on a property that is not filterable", func(t *testing.T) {
+				_, err := c.SemanticSearch(ctx, &objstore.Object{Vector: randomVector(32)}, search.NewOptions(10), &filters.Filter{
+					Operator: filters.Equal, Property: "description", Value: "blah",
+				}, nil)
+				require.ErrorIs(t, err, ErrValidationFailed)
+			})
+
+			t.Run("invalid filter", func(t *testing.T) {
+				_, err := c.SemanticSearch(ctx, &objstore.Object{Vector: randomVector(32)}, search.NewOptions(10), &filters.Filter{Operator: "like"}, nil)
+				require.ErrorIs(t, err, ErrValidationFailed)
+			})
+		})
+	}
+}
+
+func TestCollection_BinaryVectors(t *testing.T) {
+	ctx := context.Background()
+	filesPath := "./tmp_binary"
+	defer os.RemoveAll(filesPath)
+
+	params := index.DefaultHnswParams
+	params.DistanceType = distance.Hamming
+
+	cfg := collection.Collection{
+		Name:        "test_collection",
+		IndexType:   index.Hnsw,
+		DataType:    "text",
+		IndexParams: params,
+		VectorType:  collection.BinaryVectorType,
+		Mappings:    []mappings.Mapping{{Name: "title", Type: mappings.Text}},
+	}
+
+	db, err := Open(filesPath)
+	require.NoError(t, err)
+
+	t.Run("binary vectors require hamming distance", func(t *testing.T) {
+		invalid := cfg
+		invalid.Name = "invalid"
+		invalid.IndexParams = index.DefaultHnswParams
+
+		_, err := db.CreateCollection(ctx, &invalid)
+		require.ErrorIs(t, err, ErrValidationFailed)
+	})
+
+	c, err := db.CreateCollection(ctx, &cfg)
+	require.NoError(t, err)
+
+	size, dim := 500, 100
+	objs := make([]*objstore.Object, 0, size)
+	for i := 0; i < size; i++ {
+		vector := make([]float32, dim)
+		for j := range vector {
+			vector[j] = float32(rand.Intn(2))
+		}
+
+		objs = append(objs, &objstore.Object{Properties: map[string]interface{}{"title": "blah"}, Vector: vector})
+	}
+
+	require.NoError(t, c.InsertBatch(ctx, objs))
+
+	t.Run("vectors must be binary", func(t *testing.T) {
+		err := c.Insert(ctx, &objstore.Object{Properties: map[string]interface{}{"title": "blah"}, Vector: randomVector(dim)})
+		require.ErrorIs(t, err, ErrValidationFailed)
+	})
+
+	requireNearest := func(t *testing.T, c *Collection) {
+		for _, obj := range objs[:20] {
+			res, err := c.SemanticSearch(ctx, &objstore.Object{Vector: obj.Vector}, search.NewOptions(1), nil, nil)
+			require.NoError(t, err)
+			require.Len(t, res.Objects, 1)
+			require.Equal(t, obj.Id, res.Objects[0].Id)
+			require.Zero(t, res.Objects[0].Distance)
+		}
+	}
+
+	t.Run("search", func(t *testing.T) {
+		requireNearest(t, c)
+	})
+
+	t.Run("reopen", func(t *testing.T) {
+		require.NoError(t, db.Close())
+
+		db, err = Open(filesPath)
+		require.NoError(t, err)
+		defer db.Close()
+
+		c, err := db.GetCollection(ctx, cfg.Name)
+		require.NoError(t, err)
+
+		restored, err := c.GetConfig()
+		require.NoError(t, err)
+		require.Equal(t, collection.BinaryVectorType, restored.VectorType)
+
+		stored, err := c.Get([]uint64{objs[0].Id})
+		require.NoError(t, err)
+		require.Len(t, stored, 1)
+		require.Equal(t, objs[0].Vector, stored[0].Vector)
+
+		requireNearest(t, c)
+	})
+}
+
+func TestCollection_SemanticSearchOptions(t *testing.T) {
+	ctx := context.Background()
+	filesPath := "./tmp_search_options"
+	defer os.RemoveAll(filesPath)
+
+	db, err := Open(filesPath)
+	require.NoError(t, err)
+	defer db.Close()
+
+	c, err := db.CreateCollection(ctx, &collection.Collection{
+		Name:        "test_collection",
+		IndexType:   index.Hnsw,
+		DataType:    "text",
+		IndexParams: index.DefaultHnswParams,
+		Mappings:    []mappings.Mapping{{Name: "country", Type: mappings.Text, Filterable: true}},
+	})
+	require.NoError(t, err)
+
+	size := 1000
+	objs := make([]*objstore.Object, 0, size)
+	for i := 0; i < size; i++ {
+		objs = append(objs, &objstore.Object{
+			Properties: map[string]interface{}{"country": []string{"Italy", "France"}[i%2]},
+			Vector:     randomVector(32),
+		})
+	}
+
+	require.NoError(t, c.InsertBatch(ctx, objs))
+
+	q := &objstore.Object{Vector: objs[0].Vector}
+
+	t.Run("vectors and distances", func(t *testing.T) {
+		opts := search.NewOptions(5)
+		opts.IncludeVector = true
+
+		res, err := c.SemanticSearch(ctx, q, opts, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, res.Objects, 5)
+		require.Equal(t, objs[0].Vector, res.Objects[0].Vector)
+		require.Greater(t, res.Objects[4].Distance, float32(0))
+
+		res, err = c.SemanticSearch(ctx, q, search.Options{K: 5}, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, res.Objects, 5)
+
+		for _, o := range res.Objects {
+			require.Nil(t, o.Vector)
+			require.Zero(t, o.Distance)
+		}
+	})
+
+	t.Run("max distance", func(t *testing.T) {
+		all, err := c.SemanticSearch(ctx, q, search.NewOptions(100), nil, nil)
+		require.NoError(t, err)
+
+		opts := search.NewOptions(100)
+		opts.MaxDistance = &all.Objects[19].Distance
+
+		res, err := c.SemanticSearch(ctx, q, opts, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, all.Objects[:20], res.Objects)
+
+		filter := &filters.Filter{Operator: filters.Equal, Property: "country", Value: "Italy"}
+		res, err = c.SemanticSearch(ctx, q, opts, filter, nil)
+		require.NoError(t, err)
+		require.NotEmpty(t, res.Objects)
+		require.Less(t, len(res.Objects), 20)
+
+		for _, o := range res.Objects {
+			require.Equal(t, "Italy", o.Properties["country"])
+			require.LessOrEqual(t, o.Distance, *opts.MaxDistance)
+		}
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		_, err := c.SemanticSearch(ctx, q, search.NewOptions(0), nil, nil)
+		require.ErrorIs(t, err, ErrValidationFailed)
+
+		_, err = c.SemanticSearch(ctx, q, search.Options{K: 10, Ef: -1}, nil, nil)
+		require.ErrorIs(t, err, ErrValidationFailed)
+	})
+}
+
+func TestCollection_RangeSearch(t *testing.T) {
+	ctx := context.Background()
+	filesPath := "./tmp_range_search"
+	defer os.RemoveAll(filesPath)
+
+	db, err := Open(filesPath)
+	require.NoError(t, err)
+	defer db.Close()
+
+	c, err := db.CreateCollection(ctx, &collection.Collection{
+		Name:        "test_collection",
+		IndexType:   index.Hnsw,
+		DataType:    "text",
+		IndexParams: index.DefaultHnswParams,
+		Mappings:    []mappings.Mapping{{Name: "title", Type: mappings.Text}},
+	})
+	require.NoError(t, err)
+
+	base := randomVector(32)
+	objs := make([]*objstore.Object, 0, 500)
+	for i := 0; i < cap(objs); i++ {
+		vector := randomVector(32)
+		if i < 5 { // near duplicates of base
+			copy(vector, base)
+			vector[0] += float32(i) * 0.001
+		}
+
+		objs = append(objs, &objstore.Object{Properties: map[string]interface{}{"title": "blah"}, Vector: vector})
+	}
+
+	require.NoError(t, c.InsertBatch(ctx, objs))
+
+	res, err := c.RangeSearch(ctx, &objstore.Object{Vector: base}, 0.01, 0, "")
+	require.NoError(t, err)
+	require.Equal(t, 5, res.Hits)
+
+	for i, o := range res.Objects {
+		require.Equal(t, objs[i].Id, o.Id)
+		require.Equal(t, "blah", o.Properties["title"])
+		require.LessOrEqual(t, o.Distance, float32(0.01))
+	}
+
+	res, err = c.RangeSearch(ctx, &objstore.Object{Vector: base}, 0.01, 2, "")
+	require.NoError(t, err)
+	require.Equal(t, 2, res.Hits)
+
+	_, err = c.RangeSearch(ctx, &objstore.Object{Vector: base}, 0.01, -1, "")
+	require.ErrorIs(t, err, ErrValidationFailed)
+}
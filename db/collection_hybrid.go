@@ -0,0 +1,294 @@
+package db
+
+import (
+	"Vectory/entities/collection"
+	"Vectory/entities/filters"
+	objstoreentities "Vectory/entities/objstore"
+	"Vectory/entities/search"
+	"fmt"
+	"github.com/pkg/errors"
+	"sort"
+	"strings"
+)
+
+const (
+	// hybridOverFetch is the factor by which k is multiplied for the number of keyword and vector
+	// candidates fetched by a hybrid search before fusing them.
+	hybridOverFetch = 4
+
+	// rrfRankConstant dampens the weight of the top ranks in reciprocal rank fusion.
+	rrfRankConstant = 60
+)
+
+// hasIndexedMappings reports whether any property of the collection is indexed for keyword search.
+func (c *Collection) hasIndexedMappings() bool {
+	for _, m := range c.config.Mappings {
+		if m.Indexed {
+			return true
+		}
+	}
+
+	return false
+}
+
+// indexedTexts returns the texts of the properties indexed for keyword search.
+func (c *Collection) indexedTexts(properties map[string]interface{}) []string {
+	texts := make([]string, 0, len(c.config.Mappings))
+
+	for _, m := range c.config.Mappings {
+		if !m.Indexed {
+			continue
+		}
+
+		switch v := properties[m.Name].(type) {
+		case string:
+			texts = append(texts, v)
+		case []string:
+			texts = append(texts, v...)
+		case []interface{}:
+			for _, e := range v {
+				if s, ok := e.(string); ok {
+					texts = append(texts, s)
+				}
+			}
+		}
+	}
+
+	return texts
+}
+
+// indexKeywords adds obj to the keyword index, replacing its previous properties.
+func (c *Collection) indexKeywords(obj *objstoreentities.Object) {
+	if c.keywordIndex != nil {
+		c.keywordIndex.Add(obj.Id, c.indexedTexts(obj.Properties))
+	}
+}
+
+// rebuildPropertyIndexes indexes all the objects in the object store, the keyword and filter indexes are kept only
+// in memory.
+func (c *Collection) rebuildPropertyIndexes() error {
+	ids, err := c.stores.ObjectsIds(0)
+	if err != nil {
+		return errors.Wrap(err, "failed listing object store")
+	}
+
+	for _, id := range ids {
+		obj, found, err := c.stores.GetObject(id)
+		if err != nil {
+			return errors.Wrapf(err, "failed getting %d from object store", id)
+		}
+
+		if found {
+			c.indexKeywords(obj)
+			c.indexFilterable(obj)
+		}
+	}
+
+	return nil
+}
+
+// validateHybridParams checks that a hybrid search can be performed over the collection with params.
+func (c *Collection) validateHybridParams(params *collection.HybridParams) error {
+	if err := collection.ValidateHybridParams(params); err != nil {
+		return fmt.Errorf("%w: %s", ErrValidationFailed, err)
+	}
+
+	if c.keywordIndex == nil {
+		return fmt.Errorf("%w: %s", ErrValidationFailed, ErrNoIndexedMappings)
+	}
+
+	return nil
+}
+
+// hybridSearch fuses the BM25 keyword results of the query in params with the k-nn of vector, the searched vector
+// of obj, and returns the k objects with the highest fused scores within the maximum distance of opts.
+func (c *Collection) hybridSearch(obj *objstoreentities.Object, vector []float32, opts search.Options, filter *filters.Filter, params *collection.HybridParams) ([]objstoreentities.ObjectWithDistance, error) {
+	k := opts.K
+	candidates := k * hybridOverFetch
+
+	query := params.Query
+	if query == "" {
+		query = strings.Join(c.indexedTexts(obj.Properties), " ")
+	}
+
+	var (
+		vectorResults []objstoreentities.ObjectWithDistance
+		err           error
+	)
+
+	vectorOpts := opts
+	vectorOpts.K = candidates
+
+	if filter == nil {
+		vectorResults, err = c.search(vector, vectorOpts)
+	} else {
+		vectorResults, err = c.filteredSearch(vector, vectorOpts, filter)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	keywordResults, err := c.keywordSearch(query, candidates, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	objs := make(map[uint64]*objstoreentities.ObjectWithDistance, len(vectorResults)+len(keywordResults))
+	for i := range vectorResults {
+		objs[vectorResults[i].Id] = &vectorResults[i]
+	}
+
+	// the distances of objects found only by keyword are calculated from their stored vectors, a search among
+	// them is limited by ef and may miss some
+	var missing []*objstoreentities.ObjectWithDistance
+	for i := range keywordResults {
+		if _, ok := objs[keywordResults[i].Id]; !ok {
+			objs[keywordResults[i].Id] = &keywordResults[i]
+			missing = append(missing, &keywordResults[i])
+		}
+	}
+
+	if len(missing) > 0 {
+		distance, err := c.distanceFunc(opts.Vector)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, o := range missing {
+			if opts.Vector == "" {
+				o.Distance = distance(vector, o.Vector)
+			} else {
+				o.Distance = distance(vector, o.Vectors[opts.Vector])
+			}
+		}
+	}
+
+	for id, o := range objs {
+		if !opts.Within(o.Distance) {
+			delete(objs, id)
+		}
+	}
+
+	switch params.Fusion {
+	case collection.WeightedFusion:
+		weightedFusion(objs, keywordResults, params.Alpha)
+	default:
+		rrfFusion(objs, vectorResults, keywordResults)
+	}
+
+	res := make([]objstoreentities.ObjectWithDistance, 0, len(objs))
+	for _, o := range objs {
+		res = append(res, *o)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Score == res[j].Score {
+			return res[i].Distance < res[j].Distance
+		}
+
+		return res[i].Score > res[j].Score
+	})
+
+	if len(res) > k {
+		res = res[:k]
+	}
+
+	return res, nil
+}
+
+// keywordSearch returns up to k objects matching query and filter sorted by their BM25 score, which is kept in Score.
+func (c *Collection) keywordSearch(query string, k int, filter *filters.Filter) ([]objstoreentities.ObjectWithDistance, error) {
+	objs := make([]objstoreentities.ObjectWithDistance, 0, k)
+
+	for _, hit := range c.keywordIndex.Search(query) {
+		if len(objs) == k {
+			break
+		}
+
+		obj, found, err := c.stores.GetObject(hit.Id)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed getting %d from object store", hit.Id)
+		}
+
+		if !found || (filter != nil && !filter.Match(obj.Properties)) {
+			continue
+		}
+
+		objs = append(objs, objstoreentities.ObjectWithDistance{
+			Id:         obj.Id,
+			Key:        obj.Key,
+			Properties: obj.Properties,
+			Vector:     obj.Vector,
+			Vectors:    obj.Vectors,
+			Score:      hit.Score,
+		})
+	}
+
+	return objs, nil
+}
+
+// rrfFusion scores objs by the sum of their reciprocal ranks in the vector and keyword results.
+func rrfFusion(objs map[uint64]*objstoreentities.ObjectWithDistance, vectorResults, keywordResults []objstoreentities.ObjectWithDistance) {
+	scores := make(map[uint64]float32, len(objs))
+
+	for _, results := range [][]objstoreentities.ObjectWithDistance{vectorResults, keywordResults} {
+		for rank, o := range results {
+			scores[o.Id] += 1 / float32(rrfRankConstant+rank+1)
+		}
+	}
+
+	for id, o := range objs {
+		o.Score = scores[id]
+	}
+}
+
+// weightedFusion scores objs by alpha times their min-max normalized vector similarity plus 1-alpha times
+// their BM25 score divided by the highest one, objects that didn't match the keyword query have a keyword score of 0.
+func weightedFusion(objs map[uint64]*objstoreentities.ObjectWithDistance, keywordResults []objstoreentities.ObjectWithDistance, alpha float32) {
+	keywordScores := make(map[uint64]float32, len(keywordResults))
+	for _, o := range keywordResults {
+		keywordScores[o.Id] = o.Score
+	}
+
+	var minDist, maxDist float32
+	for _, o := range objs {
+		minDist, maxDist = o.Distance, o.Distance
+		break
+	}
+
+	for _, o := range objs {
+		if o.Distance < minDist {
+			minDist = o.Distance
+		}
+
+		if o.Distance > maxDist {
+			maxDist = o.Distance
+		}
+	}
+
+	var maxScore float32
+	if len(keywordResults) > 0 { // keyword results are sorted by score
+		maxScore = keywordResults[0].Score
+	}
+
+	for id, o := range objs {
+		vectorScore := normalize(maxDist-o.Distance, maxDist-minDist)
+
+		var keywordScore float32
+		if s, ok := keywordScores[id]; ok {
+			keywordScore = s / maxScore
+		}
+
+		o.Score = alpha*vectorScore + (1-alpha)*keywordScore
+	}
+}
+
+// normalize returns v divided by the range of its values, or 1 when all values are equal
+func normalize(v, valuesRange float32) float32 {
+	if valuesRange == 0 {
+		return 1
+	}
+
+	return v / valuesRange
+}
@@ -0,0 +1,221 @@
+package db
+
+import (
+	coredistance "Vectory/db/core/index/distance"
+	"Vectory/entities/collection"
+	"Vectory/entities/distance"
+	"Vectory/entities/filters"
+	"Vectory/entities/index"
+	"Vectory/entities/mappings"
+	"Vectory/entities/objstore"
+	"Vectory/entities/search"
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"os"
+	"testing"
+)
+
+func TestCollection_HybridSearch(t *testing.T) {
+	ctx := context.Background()
+	filesPath := "./tmp_hybrid"
+	defer os.RemoveAll(filesPath)
+
+	cfg := collection.Collection{
+		Name:        "test_collection",
+		IndexType:   index.Hnsw,
+		DataType:    "text",
+		IndexParams: index.DefaultHnswParams,
+		Mappings: []mappings.Mapping{
+			{Name: "title", Type: mappings.Text, Indexed: true},
+			{Name: "tags", Type: mappings.StringArray, Indexed: true},
+			{Name: "country", Type: mappings.Text, Filterable: true},
+		},
+	}
+
+	db, err := Open(filesPath)
+	require.NoError(t, err)
+
+	c, err := db.CreateCollection(ctx, &cfg)
+	require.NoError(t, err)
+
+	countries := []string{"Italy", "France"}
+	size, dim := 1000, 32
+
+	objs := make([]*objstore.Object, 0, size)
+	for i := 0; i < size; i++ {
+		objs = append(objs, &objstore.Object{
+			Properties: map[string]interface{}{
+				"title":   fmt.Sprintf("red wine number %d", i),
+				"tags":    []string{"wine", countries[i%len(countries)]},
+				"country": countries[i%len(countries)],
+			},
+			Vector: randomVector(dim),
+		})
+	}
+
+	objs[42].Properties["title"] = "Chianti Classico ZX-9000"
+	objs[43].Properties["tags"] = []string{"wine", "zx-9000"}
+
+	require.NoError(t, c.InsertBatch(ctx, objs))
+
+	t.Run("rrf", func(t *testing.T) {
+		res, err := c.SemanticSearch(ctx, &objstore.Object{Vector: randomVector(dim)}, search.NewOptions(5), nil, &collection.HybridParams{Query: "zx-9000"})
+		require.NoError(t, err)
+		require.Len(t, res.Objects, 5)
+		require.Greater(t, res.Objects[0].Score, res.Objects[4].Score)
+
+		// the top keyword results are ranked along with the top vector results
+		ids := make([]uint64, 0, len(res.Objects))
+		for _, o := range res.Objects {
+			ids = append(ids, o.Id)
+		}
+
+		require.Contains(t, ids, objs[42].Id)
+		require.Contains(t, ids, objs[43].Id)
+	})
+
+	t.Run("query defaults to the indexed properties", func(t *testing.T) {
+		res, err := c.SemanticSearch(ctx, &objstore.Object{
+			Properties: map[string]interface{}{"title": "chianti", "country": "blah"},
+			Vector:     randomVector(dim),
+		}, search.NewOptions(1), nil, &collection.HybridParams{Fusion: collection.WeightedFusion})
+		require.NoError(t, err)
+		require.Len(t, res.Objects, 1)
+		require.Equal(t, objs[42].Id, res.Objects[0].Id)
+	})
+
+	t.Run("weighted", func(t *testing.T) {
+		vector := randomVector(dim)
+
+		res, err := c.SemanticSearch(ctx, &objstore.Object{Vector: vector}, search.NewOptions(10), nil, &collection.HybridParams{
+			Query:  "chianti",
+			Fusion: collection.WeightedFusion,
+		})
+		require.NoError(t, err)
+		require.Equal(t, objs[42].Id, res.Objects[0].Id)
+		require.Equal(t, float32(1), res.Objects[0].Score)
+
+		res, err = c.SemanticSearch(ctx, &objstore.Object{Vector: vector}, search.NewOptions(10), nil, &collection.HybridParams{
+			Query:  "chianti",
+			Fusion: collection.WeightedFusion,
+			Alpha:  1,
+		})
+		require.NoError(t, err)
+
+		expected, err := c.SemanticSearch(ctx, &objstore.Object{Vector: vector}, search.NewOptions(10), nil, nil)
+		require.NoError(t, err)
+
+		for i := range expected.Objects {
+			require.Equal(t, expected.Objects[i].Id, res.Objects[i].Id)
+			require.Equal(t, expected.Objects[i].Distance, res.Objects[i].Distance)
+		}
+	})
+
+	t.Run("distances of objects found only by keyword", func(t *testing.T) {
+		many := cfg
+		many.Name = "many_keyword_hits"
+		many.IndexParams = index.HnswParams{M: 4, MMax: 8, EfConstruction: 16, Ef: 16, Heuristic: true, DistanceType: distance.Euclidean}
+
+		c, err := db.CreateCollection(ctx, &many)
+		require.NoError(t, err)
+
+		manyObjs := make([]*objstore.Object, 0, 6000)
+		for i := 0; i < cap(manyObjs); i++ {
+			manyObjs = append(manyObjs, &objstore.Object{
+				Properties: map[string]interface{}{"title": "red wine", "tags": []string{"wine"}, "country": "Italy"},
+				Vector:     randomVector(64),
+			})
+		}
+
+		require.NoError(t, c.InsertBatch(ctx, manyObjs))
+
+		stored := make(map[uint64]*objstore.Object, len(manyObjs))
+		for _, o := range manyObjs {
+			stored[o.Id] = o
+		}
+
+		// every object matches the query, so there are many more keyword results than ef and a search among them
+		// in the sparse graph misses some
+		vector := randomVector(64)
+		res, err := c.SemanticSearch(ctx, &objstore.Object{Vector: vector}, search.NewOptions(1000), nil, &collection.HybridParams{Query: "wine", Fusion: collection.WeightedFusion})
+		require.NoError(t, err)
+		require.Len(t, res.Objects, 1000)
+
+		for _, o := range res.Objects {
+			require.InDelta(t, coredistance.EuclideanDistance(vector, stored[o.Id].Vector), o.Distance, 1e-5)
+		}
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		res, err := c.SemanticSearch(ctx, &objstore.Object{Vector: randomVector(dim)}, search.NewOptions(10), &filters.Filter{
+			Operator: filters.Equal, Property: "country", Value: "France",
+		}, &collection.HybridParams{Query: "zx-9000", Fusion: collection.WeightedFusion})
+		require.NoError(t, err)
+		require.Len(t, res.Objects, 10)
+		require.Equal(t, objs[43].Id, res.Objects[0].Id)
+
+		for _, o := range res.Objects {
+			require.Equal(t, "France", o.Properties["country"])
+		}
+	})
+
+	t.Run("invalid params", func(t *testing.T) {
+		_, err := c.SemanticSearch(ctx, &objstore.Object{Vector: randomVector(dim)}, search.NewOptions(10), nil, &collection.HybridParams{Fusion: "blah"})
+		require.ErrorIs(t, err, ErrValidationFailed)
+
+		_, err = c.SemanticSearch(ctx, &objstore.Object{Vector: randomVector(dim)}, search.NewOptions(10), nil, &collection.HybridParams{Alpha: 2})
+		require.ErrorIs(t, err, ErrValidationFailed)
+
+		notIndexed := cfg
+		notIndexed.Name = "not_indexed"
+		notIndexed.Mappings = []mappings.Mapping{{Name: "title", Type: mappings.Text}}
+
+		nc, err := db.CreateCollection(ctx, &notIndexed)
+		require.NoError(t, err)
+
+		_, err = nc.SemanticSearch(ctx, &objstore.Object{Vector: randomVector(dim)}, search.NewOptions(10), nil, &collection.HybridParams{Query: "blah"})
+		require.ErrorIs(t, err, ErrValidationFailed)
+	})
+
+	t.Run("update and delete", func(t *testing.T) {
+		updated := *objs[42]
+		updated.Properties = map[string]interface{}{"title": "Barolo", "tags": []string{"wine"}, "country": "Italy"}
+		updated.Vector = nil
+		require.NoError(t, c.Update(ctx, &updated))
+		require.NoError(t, c.Delete(objs[43].Id))
+
+		res, err := c.SemanticSearch(ctx, &objstore.Object{Vector: randomVector(dim)}, search.NewOptions(1), nil, &collection.HybridParams{
+			Query:  "zx-9000 barolo",
+			Fusion: collection.WeightedFusion,
+		})
+		require.NoError(t, err)
+		require.Equal(t, objs[42].Id, res.Objects[0].Id)
+		require.Equal(t, "Barolo", res.Objects[0].Properties["title"])
+
+		res, err = c.SemanticSearch(ctx, &objstore.Object{Vector: randomVector(dim)}, search.NewOptions(1), nil, &collection.HybridParams{
+			Query:  "zx-9000",
+			Fusion: collection.WeightedFusion,
+		})
+		require.NoError(t, err)
+		require.NotEqual(t, objs[43].Id, res.Objects[0].Id)
+	})
+
+	t.Run("keyword index is rebuilt on reopen", func(t *testing.T) {
+		require.NoError(t, db.Close())
+
+		db, err = Open(filesPath)
+		require.NoError(t, err)
+		defer db.Close()
+
+		c, err := db.GetCollection(ctx, cfg.Name)
+		require.NoError(t, err)
+
+		res, err := c.SemanticSearch(ctx, &objstore.Object{Vector: randomVector(dim)}, search.NewOptions(1), nil, &collection.HybridParams{
+			Query:  "barolo",
+			Fusion: collection.WeightedFusion,
+		})
+		require.NoError(t, err)
+		require.Equal(t, objs[42].Id, res.Objects[0].Id)
+	})
+}
@@ -0,0 +1,366 @@
+package db
+
+import (
+	"Vectory/db/core/index"
+	"Vectory/db/core/objstore"
+	"Vectory/entities/collection"
+	objstoreentities "Vectory/entities/objstore"
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/pkg/errors"
+	"io"
+	"sort"
+)
+
+// exportedObject is an object in the JSON lines written by an export
+type exportedObject struct {
+	Id         uint64                 `json:"id"`
+	Key        string                 `json:"key,omitempty"`
+	Properties map[string]interface{} `json:"properties"`
+	Vector     []float32              `json:"vector"`
+	Vectors    map[string][]float32   `json:"vectors,omitempty"`
+}
+
+// Export writes the objects of the collection to w as JSON lines ordered by their ids and returns their number.
+// writes to the collection are blocked while they're written but searches are still served.
+func (c *Collection) Export(ctx context.Context, w io.Writer) (int, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.closed {
+		return 0, ErrCollectionClosed
+	}
+
+	return ExportObjects(ctx, c.stores, w)
+}
+
+// ExportObjects writes the objects of stores to w the same way as Collection.Export, for stores that aren't
+// opened by a collection.
+func ExportObjects(ctx context.Context, stores *objstore.Stores, w io.Writer) (int, error) {
+	ids, err := stores.ObjectsIds(0)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed listing object ids")
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+
+	var n int
+	for _, id := range ids {
+		if err = ctx.Err(); err != nil {
+			return n, err
+		}
+
+		obj, found, err := stores.GetObject(id)
+		if err != nil {
+			return n, errors.Wrapf(err, "failed getting %d from object store", id)
+		}
+
+		if !found {
+			continue
+		}
+
+		if err = enc.Encode(exportedObject{Id: obj.Id, Key: obj.Key, Properties: obj.Properties, Vector: obj.Vector, Vectors: obj.Vectors}); err != nil {
+			return n, err
+		}
+
+		n++
+	}
+
+	return n, bw.Flush()
+}
+
+// Import inserts the objects of the JSON lines read from r to the collection in batches, one object per line.
+// the fields of the records are mapped to the objects by opts' mapping, and the objects get new ids. records that
+// can't be decoded or inserted are counted as failed without stopping the import, empty lines are counted as
+// records but skipped. an error reading r, a failed batch or ctx stop the import, and the returned result tells
+// how many records were handled so it can be resumed with opts' Skip.
+func (c *Collection) Import(ctx context.Context, r io.Reader, opts collection.ImportOptions) (res *collection.ImportResult, err error) {
+	if opts.Bulk {
+		if err = c.beginBulk(); err != nil {
+			return nil, err
+		}
+
+		defer func() {
+			if endErr := c.endBulk(); endErr != nil && err == nil {
+				err = endErr
+			}
+		}()
+	}
+
+	return c.importRecords(ctx, r, opts)
+}
+
+func (c *Collection) importRecords(ctx context.Context, r io.Reader, opts collection.ImportOptions) (*collection.ImportResult, error) {
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = collection.DefaultImportBatchSize
+	}
+
+	res := collection.ImportResult{Records: opts.Skip, Errors: []collection.ImportError{}}
+
+	fail := func(record int, err error) {
+		res.Failed++
+
+		if len(res.Errors) < collection.MaxImportErrors {
+			res.Errors = append(res.Errors, collection.ImportError{Record: record, Err: err})
+		}
+	}
+
+	br := bufio.NewReader(r)
+
+	// records of the current batch, they're added to the result only when it's inserted
+	var (
+		batch   = make([]*objstoreentities.Object, 0, batchSize)
+		records = make([]int, 0, batchSize)
+		lines   int
+	)
+
+	flush := func() error {
+		if len(batch) > 0 {
+			batchRes, err := c.importBatch(ctx, batch, opts.Bulk)
+			if err != nil {
+				return errors.Wrapf(err, "failed inserting records %d to %d", records[0], records[len(records)-1])
+			}
+
+			for i, o := range batchRes.Objects {
+				if o.Err != nil {
+					fail(records[i], o.Err)
+					continue
+				}
+
+				res.Imported++
+			}
+		}
+
+		res.Records += lines
+		batch, records, lines = batch[:0], records[:0], 0
+
+		if opts.Progress != nil {
+			opts.Progress(res)
+		}
+
+		return nil
+	}
+
+	for record := 0; ; record++ {
+		line, readErr := br.ReadBytes('\n')
+		if readErr != nil && readErr != io.EOF {
+			return &res, errors.Wrapf(readErr, "failed reading record %d", record)
+		}
+
+		if readErr == io.EOF && len(line) == 0 {
+			break
+		}
+
+		if record < opts.Skip {
+			continue
+		}
+
+		if err := ctx.Err(); err != nil {
+			return &res, err
+		}
+
+		lines++
+
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			obj, err := decodeRecord(line, &opts.Mapping)
+			if err != nil {
+				fail(record, err)
+			} else {
+				batch = append(batch, obj)
+				records = append(records, record)
+			}
+		}
+
+		if len(batch) == batchSize {
+			if err := flush(); err != nil {
+				return &res, err
+			}
+		}
+
+		if readErr == io.EOF {
+			break
+		}
+	}
+
+	if lines > 0 {
+		if err := flush(); err != nil {
+			return &res, err
+		}
+	}
+
+	return &res, nil
+}
+
+// decodeRecord decodes the object of a JSON record of an import by mapping.
+func decodeRecord(line []byte, mapping *collection.ImportMapping) (*objstoreentities.Object, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(line, &fields); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrValidationFailed, err)
+	}
+
+	// decode decodes the field with name to v when it's present
+	decode := func(name string, v interface{}) error {
+		raw, ok := fields[name]
+		if !ok {
+			return nil
+		}
+
+		if err := json.Unmarshal(raw, v); err != nil {
+			return fmt.Errorf("%w: field %s: %s", ErrValidationFailed, name, err)
+		}
+
+		return nil
+	}
+
+	var obj objstoreentities.Object
+
+	if err := decode(fieldOrDefault(mapping.Key, "key"), &obj.Key); err != nil {
+		return nil, err
+	}
+
+	if err := decode(fieldOrDefault(mapping.Vector, "vector"), &obj.Vector); err != nil {
+		return nil, err
+	}
+
+	if len(mapping.Vectors) == 0 {
+		if err := decode("vectors", &obj.Vectors); err != nil {
+			return nil, err
+		}
+	} else {
+		for name, field := range mapping.Vectors {
+			var vector []float32
+			if err := decode(field, &vector); err != nil {
+				return nil, err
+			}
+
+			if vector == nil {
+				continue
+			}
+
+			if obj.Vectors == nil {
+				obj.Vectors = make(map[string][]float32, len(mapping.Vectors))
+			}
+
+			obj.Vectors[name] = vector
+		}
+	}
+
+	if len(mapping.Properties) == 0 {
+		if err := decode("properties", &obj.Properties); err != nil {
+			return nil, err
+		}
+	} else {
+		obj.Properties = make(map[string]interface{}, len(mapping.Properties))
+
+		for _, field := range mapping.Properties {
+			var v interface{}
+			if err := decode(field, &v); err != nil {
+				return nil, err
+			}
+
+			if _, ok := fields[field]; ok {
+				obj.Properties[field] = v
+			}
+		}
+	}
+
+	return &obj, nil
+}
+
+// importBatch inserts a batch of records of an import. the batches of a bulk import are the only writes
+// allowed while it runs.
+func (c *Collection) importBatch(ctx context.Context, objs []*objstoreentities.Object, bulk bool) (*collection.BatchResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.writable(); err != nil && !(bulk && err == ErrBulkImportRunning) {
+		return nil, err
+	}
+
+	return c.insertBatchWithResults(ctx, objs)
+}
+
+// beginBulk stops persisting each modification of the vector indexes that are built faster in bulk, and rejects
+// the other writes to the collection until endBulk, since they wouldn't be persisted by the indexes either.
+// only empty collections are bulk imported, so that a bulk import that is interrupted rolls back nothing else.
+func (c *Collection) beginBulk() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.writable(); err != nil {
+		return err
+	}
+
+	if c.stores.Size() > 0 {
+		return fmt.Errorf("%w: %s", ErrValidationFailed, ErrBulkImportNotEmpty)
+	}
+
+	builders := c.bulkBuilders()
+	for i, idx := range builders {
+		if err := idx.BeginBulk(); err != nil {
+			for _, begun := range builders[:i] {
+				_ = begun.EndBulk()
+			}
+
+			return errors.Wrap(err, "failed beginning bulk build of vector index")
+		}
+	}
+
+	c.bulk = true
+
+	return nil
+}
+
+// endBulk persists the modifications of the vector indexes made since beginBulk.
+func (c *Collection) endBulk() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.bulk = false
+
+	if c.closed {
+		return ErrCollectionClosed
+	}
+
+	for _, idx := range c.bulkBuilders() {
+		if err := idx.EndBulk(); err != nil {
+			return errors.Wrap(err, "failed ending bulk build of vector index")
+		}
+	}
+
+	return nil
+}
+
+// bulkBuilders returns the vector indexes of the collection that are built faster in bulk.
+func (c *Collection) bulkBuilders() []index.BulkBuilder {
+	var builders []index.BulkBuilder
+
+	if b, ok := c.vectorIndex.(index.BulkBuilder); ok {
+		builders = append(builders, b)
+	}
+
+	for _, idx := range c.namedIndexes {
+		if b, ok := idx.(index.BulkBuilder); ok {
+			builders = append(builders, b)
+		}
+	}
+
+	return builders
+}
+
+func fieldOrDefault(field, def string) string {
+	if field == "" {
+		return def
+	}
+
+	return field
+}
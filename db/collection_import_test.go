@@ -0,0 +1,225 @@
+package db
+
+import (
+	"Vectory/db/core/index/hnsw"
+	"Vectory/entities/collection"
+	"Vectory/entities/index"
+	"Vectory/entities/mappings"
+	"Vectory/entities/objstore"
+	"Vectory/entities/search"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestCollection_Import(t *testing.T) {
+	ctx := context.Background()
+	filesPath := "./tmp_import"
+	defer os.RemoveAll(filesPath)
+
+	newConfig := func(name string) *collection.Collection {
+		return &collection.Collection{
+			Name:         name,
+			IndexType:    index.Hnsw,
+			DataType:     "text",
+			IndexParams:  index.DefaultHnswParams,
+			Mappings:     []mappings.Mapping{{Name: "title", Type: mappings.Text}},
+			NamedVectors: []index.NamedVector{{Name: "body", IndexType: index.Hnsw, IndexParams: index.DefaultHnswParams}},
+		}
+	}
+
+	db, err := Open(filesPath)
+	require.NoError(t, err)
+	defer db.Close()
+
+	src, err := db.CreateCollection(ctx, newConfig("src"))
+	require.NoError(t, err)
+
+	size, dim := 100, 16
+	objs := make([]*objstore.Object, 0, size)
+	for i := 0; i < size; i++ {
+		objs = append(objs, &objstore.Object{
+			Key:        fmt.Sprintf("doc-%d", i),
+			Properties: map[string]interface{}{"title": fmt.Sprintf("title %d", i)},
+			Vector:     randomVector(dim),
+			Vectors:    map[string][]float32{"body": randomVector(8)},
+		})
+	}
+
+	require.NoError(t, src.InsertBatch(ctx, objs))
+	require.NoError(t, src.Delete(objs[0].Id))
+
+	var exported bytes.Buffer
+
+	n, err := src.Export(ctx, &exported)
+	require.NoError(t, err)
+	require.Equal(t, size-1, n)
+	require.Equal(t, size-1, strings.Count(exported.String(), "\n"))
+
+	t.Run("export round trip", func(t *testing.T) {
+		dst, err := db.CreateCollection(ctx, newConfig("dst"))
+		require.NoError(t, err)
+
+		var progress []int
+
+		res, err := dst.Import(ctx, bytes.NewReader(exported.Bytes()), collection.ImportOptions{
+			BatchSize: 30,
+			Progress: func(res collection.ImportResult) {
+				progress = append(progress, res.Records)
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, size-1, res.Records)
+		require.Equal(t, size-1, res.Imported)
+		require.Zero(t, res.Failed)
+		require.Equal(t, []int{30, 60, 90, 99}, progress)
+
+		imported, err := dst.GetByKeys([]string{"doc-0", "doc-1", "doc-99"})
+		require.NoError(t, err)
+		require.Len(t, imported, 2)
+		require.Equal(t, objs[1].Properties, imported[0].Properties)
+		require.Equal(t, objs[1].Vector, imported[0].Vector)
+		require.Equal(t, objs[1].Vectors, imported[0].Vectors)
+	})
+
+	t.Run("mapping, failures and resume", func(t *testing.T) {
+		dst, err := db.CreateCollection(ctx, newConfig("mapped"))
+		require.NoError(t, err)
+
+		var input bytes.Buffer
+		for i := 0; i < 10; i++ {
+			switch i {
+			case 3:
+				input.WriteString("{not json\n")
+			case 5:
+				input.WriteString("\n")
+			case 7:
+				input.WriteString(`{"title": "title 7", "emb": "not a vector"}` + "\n")
+			default:
+				key := fmt.Sprintf("doc-%d", i)
+				if i == 8 {
+					key = "doc-0"
+				}
+
+				record, err := json.Marshal(map[string]interface{}{
+					"id":       key,
+					"title":    fmt.Sprintf("title %d", i),
+					"ignored":  i,
+					"emb":      randomVector(dim),
+					"body_emb": randomVector(8),
+				})
+				require.NoError(t, err)
+
+				input.Write(append(record, '\n'))
+			}
+		}
+
+		opts := collection.ImportOptions{
+			Mapping: collection.ImportMapping{
+				Key:        "id",
+				Vector:     "emb",
+				Vectors:    map[string]string{"body": "body_emb"},
+				Properties: []string{"title"},
+			},
+			BatchSize: 4,
+		}
+
+		// an interrupted import is resumed from the records of its last progress
+		cctx, cancel := context.WithCancel(ctx)
+		opts.Progress = func(collection.ImportResult) { cancel() }
+
+		res, err := dst.Import(cctx, bytes.NewReader(input.Bytes()), opts)
+		require.ErrorIs(t, err, context.Canceled)
+		require.Equal(t, 5, res.Records)
+		require.Equal(t, 4, res.Imported)
+		require.Equal(t, 1, res.Failed)
+		require.Equal(t, 3, res.Errors[0].Record)
+
+		opts.Progress = nil
+		opts.Skip = res.Records
+
+		res, err = dst.Import(ctx, bytes.NewReader(input.Bytes()), opts)
+		require.NoError(t, err)
+		require.Equal(t, 10, res.Records)
+		require.Equal(t, 2, res.Imported)
+		require.Equal(t, 2, res.Failed)
+		require.Equal(t, 7, res.Errors[0].Record)
+		require.ErrorIs(t, res.Errors[0].Err, ErrValidationFailed)
+		require.Equal(t, 8, res.Errors[1].Record)
+		require.Contains(t, res.Errors[1].Err.Error(), ErrKeyAlreadyExists.Error())
+
+		imported, err := dst.GetByKeys([]string{"doc-2", "doc-3", "doc-6", "doc-9"})
+		require.NoError(t, err)
+		require.Len(t, imported, 3)
+		require.Equal(t, map[string]interface{}{"title": "title 2"}, imported[0].Properties)
+		require.Len(t, imported[1].Vector, dim)
+		require.Len(t, imported[1].Vectors["body"], 8)
+	})
+
+	t.Run("bulk", func(t *testing.T) {
+		c, err := db.CreateCollection(ctx, newConfig("bulk"))
+		require.NoError(t, err)
+
+		// other writes are rejected while the bulk import runs, since they wouldn't be persisted
+		var insertErr error
+		during := hookReader(func() {
+			insertErr = c.Insert(ctx, &objstore.Object{Key: "during-bulk", Properties: map[string]interface{}{"title": "blah"}, Vector: objs[0].Vector})
+		})
+
+		lines := bytes.SplitAfterN(exported.Bytes(), []byte("\n"), 50)
+		records := io.MultiReader(bytes.NewReader(bytes.Join(lines[:49], nil)), during, bytes.NewReader(lines[49]))
+
+		res, err := c.Import(ctx, records, collection.ImportOptions{BatchSize: 30, Bulk: true})
+		require.NoError(t, err)
+		require.Equal(t, size-1, res.Imported)
+		require.ErrorIs(t, insertErr, ErrBulkImportRunning)
+
+		// only empty collections are bulk imported
+		_, err = c.Import(ctx, bytes.NewReader(exported.Bytes()), collection.ImportOptions{Bulk: true})
+		require.ErrorIs(t, err, ErrValidationFailed)
+		require.ErrorContains(t, err, ErrBulkImportNotEmpty.Error())
+
+		require.NoError(t, db.Close())
+
+		// the graphs are persisted only by their snapshots
+		for _, vector := range []string{"", "body"} {
+			err = hnsw.ReadWAL(IndexPath(filesPath, "bulk", vector), func(hnsw.WALRecord) error {
+				return errors.New("unexpected WAL record")
+			})
+			require.NoError(t, err)
+		}
+
+		db, err = Open(filesPath)
+		require.NoError(t, err)
+		defer db.Close()
+
+		c, err = db.GetCollection(ctx, "bulk")
+		require.NoError(t, err)
+
+		size, err := c.GetSize()
+		require.NoError(t, err)
+		require.Equal(t, res.Imported, size)
+
+		for _, obj := range objs[1:10] {
+			found, err := c.SemanticSearch(ctx, &objstore.Object{Vector: obj.Vector}, search.NewOptions(1), nil, nil)
+			require.NoError(t, err)
+			require.Equal(t, obj.Key, found.Objects[0].Key)
+		}
+	})
+}
+
+// hookReader calls its function when it's read, and reads nothing
+type hookReader func()
+
+func (h hookReader) Read([]byte) (int, error) {
+	h()
+
+	return 0, io.EOF
+}
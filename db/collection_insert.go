@@ -1,20 +1,53 @@
 package db
 
 import (
+	"Vectory/entities/collection"
 	objstoreentities "Vectory/entities/objstore"
 	"context"
+	"fmt"
 )
 
+// BatchError is returned when inserting some of the objects of a batch failed while the others were inserted.
+// the objects that failed are rolled back.
+type BatchError struct {
+	Result *collection.BatchResult
+}
+
+func (e *BatchError) Error() string {
+	var first error
+	for _, o := range e.Result.Objects {
+		if o.Err != nil {
+			first = o.Err
+			break
+		}
+	}
+
+	return fmt.Sprintf("%s: %d of %d objects failed, first error: %s", ErrBatchPartiallyApplied, e.Result.Failed, len(e.Result.Objects), first)
+}
+
+func (e *BatchError) Unwrap() error {
+	return ErrBatchPartiallyApplied
+}
+
 // Insert inserts one object to the collection.
 func (c *Collection) Insert(ctx context.Context, obj *objstoreentities.Object) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if c.closed {
-		return ErrCollectionClosed
+	if err := c.writable(); err != nil {
+		return err
 	}
 
-	if err := c.validateObjectsMappings([]*objstoreentities.Object{obj}); err != nil {
+	return c.insertOne(ctx, obj)
+}
+
+// insertOne validates, embeds and inserts obj, and flushes the indexes.
+func (c *Collection) insertOne(ctx context.Context, obj *objstoreentities.Object) error {
+	if err := c.validateObjectMappings(obj); err != nil {
+		return err
+	}
+
+	if err := c.validateObjectKey(obj, make(map[string]struct{})); err != nil {
 		return err
 	}
 
@@ -22,39 +55,210 @@ func (c *Collection) Insert(ctx context.Context, obj *objstoreentities.Object) e
 		return err
 	}
 
-	if err := c.insert(obj); err != nil {
+	if err := c.validateObjectVector(obj); err != nil {
 		return err
 	}
 
-	if err := c.vectorIndex.Flush(); err != nil {
+	if err := c.validateObjectNamedVectors(obj); err != nil {
 		return err
 	}
 
-	return nil
+	if err := c.beginInsert([]*objstoreentities.Object{obj}); err != nil {
+		return err
+	}
+
+	err := c.insert(obj)
+
+	if commitErr := c.commitInserts([]*objstoreentities.Object{obj}, []error{err}); commitErr != nil {
+		return commitErr
+	}
+
+	return err
 }
 
 // InsertBatch inserts a batch of objects to the collection.
 // it does that by splitting the batch into equally sized chunks distributed among multiple worker threads.
+// an invalid object fails the entire batch before anything is inserted, while an object that fails to be inserted
+// doesn't stop the insertion of the others and a *BatchError with the outcome of each object is returned.
 func (c *Collection) InsertBatch(ctx context.Context, objs []*objstoreentities.Object) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if c.closed {
-		return ErrCollectionClosed
+	if err := c.writable(); err != nil {
+		return err
+	}
+
+	if err := c.prepareBatch(ctx, objs); err != nil {
+		return err
+	}
+
+	if err := c.beginInsert(objs); err != nil {
+		return err
+	}
+
+	return c.batchError(objs, c.insertChunks(ctx, objs))
+}
+
+// InsertBatch2 is the same as InsertBatch but creates a channel from objs and share it among the worker threads.
+func (c *Collection) InsertBatch2(ctx context.Context, objs []*objstoreentities.Object) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.writable(); err != nil {
+		return err
+	}
+
+	if err := c.prepareBatch(ctx, objs); err != nil {
+		return err
+	}
+
+	if err := c.beginInsert(objs); err != nil {
+		return err
+	}
+
+	positions := make(chan int, len(objs))
+	for i := range objs {
+		positions <- i
+	}
+	close(positions)
+
+	errs := make([]error, len(objs))
+	group := c.wp.Group()
+
+	for i := 0; i < c.wp.MaxWorkers(); i++ {
+		group.Submit(func() {
+			for {
+				pos, ok := <-positions
+				if !ok {
+					break
+				}
+
+				if errs[pos] = ctx.Err(); errs[pos] == nil {
+					errs[pos] = c.insert(objs[pos])
+				}
+			}
+		})
+	}
+
+	group.Wait()
+
+	return c.batchError(objs, errs)
+}
+
+// InsertBatchWithResults inserts a batch of objects to the collection the same way as InsertBatch, but an invalid
+// object doesn't fail the others either. the result holds the outcome of each object, and an error is returned
+// only when the batch as a whole failed.
+func (c *Collection) InsertBatchWithResults(ctx context.Context, objs []*objstoreentities.Object) (*collection.BatchResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.writable(); err != nil {
+		return nil, err
+	}
+
+	return c.insertBatchWithResults(ctx, objs)
+}
+
+// insertBatchWithResults inserts objs as described by InsertBatchWithResults.
+func (c *Collection) insertBatchWithResults(ctx context.Context, objs []*objstoreentities.Object) (*collection.BatchResult, error) {
+	errs := make([]error, len(objs))
+
+	// validate runs fn on the objects that are still valid and marks the ones it fails
+	validate := func(fn func(obj *objstoreentities.Object) error) {
+		for i, obj := range objs {
+			if errs[i] == nil {
+				errs[i] = fn(obj)
+			}
+		}
+	}
+
+	validate(c.validateObjectMappings)
+
+	pending := make([]*objstoreentities.Object, 0, len(objs))
+	for i, obj := range objs {
+		if errs[i] == nil {
+			pending = append(pending, obj)
+		}
 	}
 
+	if err := c.embedObjectsIfNeeded(ctx, pending); err != nil { // the vectors are set only when all embeddings succeed
+		validate(func(obj *objstoreentities.Object) error {
+			if obj.Vector == nil {
+				return err
+			}
+
+			return nil
+		})
+	}
+
+	validate(c.validateObjectVector)
+	validate(c.validateObjectNamedVectors)
+
+	keys := make(map[string]struct{})
+	validate(func(obj *objstoreentities.Object) error { // last, so only the keys of valid objects are reserved
+		return c.validateObjectKey(obj, keys)
+	})
+
+	valid := make([]*objstoreentities.Object, 0, len(objs))
+	for i, obj := range objs {
+		if errs[i] == nil {
+			valid = append(valid, obj)
+		}
+	}
+
+	if len(valid) == 0 {
+		return newBatchResult(objs, errs), nil
+	}
+
+	if err := c.beginInsert(valid); err != nil {
+		return nil, err
+	}
+
+	insertErrs := c.insertChunks(ctx, valid)
+
+	for i, j := 0, 0; i < len(objs); i++ {
+		if errs[i] == nil {
+			errs[i] = insertErrs[j]
+			j++
+		}
+	}
+
+	if err := c.commitInserts(valid, insertErrs); err != nil {
+		return nil, err
+	}
+
+	return newBatchResult(objs, errs), nil
+}
+
+// prepareBatch validates and embeds objs before they are inserted, it fails on the first invalid object.
+func (c *Collection) prepareBatch(ctx context.Context, objs []*objstoreentities.Object) error {
 	if err := c.validateObjectsMappings(objs); err != nil {
 		return err
 	}
 
+	if err := c.validateObjectsKeys(objs); err != nil {
+		return err
+	}
+
 	if err := c.embedObjectsIfNeeded(ctx, objs); err != nil {
 		return err
 	}
 
+	if err := c.validateObjectsVectors(objs); err != nil {
+		return err
+	}
+
+	return c.validateObjectsNamedVectors(objs)
+}
+
+// insertChunks inserts objs by splitting them into equally sized chunks distributed among multiple worker threads,
+// and returns the insertion error of each object. a failed object doesn't stop the insertion of the others, while
+// the objects that weren't inserted yet when ctx is done fail with its error.
+func (c *Collection) insertChunks(ctx context.Context, objs []*objstoreentities.Object) []error {
+	errs := make([]error, len(objs))
 	workers := c.wp.MaxWorkers()
 	objsInChunk := len(objs) / workers
-	group, ctx := c.wp.GroupContext(ctx)
-	defer ctx.Done()
+	group := c.wp.Group()
 
 	var offset int
 
@@ -64,16 +268,13 @@ func (c *Collection) InsertBatch(ctx context.Context, objs []*objstoreentities.O
 			end += len(objs) % workers
 		}
 
-		workerFunc := func(start, end int) func() error {
-			return func() error {
-				for _, obj := range objs[start:end] {
-					err := c.insert(obj)
-					if err != nil { // TODO: dont fail the entire batch?
-						return err
+		workerFunc := func(start, end int) func() {
+			return func() {
+				for j := start; j < end; j++ {
+					if errs[j] = ctx.Err(); errs[j] == nil {
+						errs[j] = c.insert(objs[j])
 					}
 				}
-
-				return nil
 			}
 		}(offset, end)
 
@@ -82,79 +283,56 @@ func (c *Collection) InsertBatch(ctx context.Context, objs []*objstoreentities.O
 		offset = end
 	}
 
-	err := group.Wait()
-	if err != nil {
-		return err
-	}
+	group.Wait()
 
-	return c.vectorIndex.Flush()
+	return errs
 }
 
-// InsertBatch2 is the same as InsertBatch but creates a channel from objs and share it among the worker threads.
-func (c *Collection) InsertBatch2(ctx context.Context, objs []*objstoreentities.Object) error {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-
-	if c.closed {
-		return ErrCollectionClosed
-	}
-
-	if err := c.validateObjectsMappings(objs); err != nil {
-		return err
-	}
-
-	if err := c.embedObjectsIfNeeded(ctx, objs); err != nil {
+// batchError commits the insertion of objs that were inserted with errs, and returns a *BatchError when some
+// of them failed.
+func (c *Collection) batchError(objs []*objstoreentities.Object, errs []error) error {
+	if err := c.commitInserts(objs, errs); err != nil {
 		return err
 	}
 
-	objects := make(chan *objstoreentities.Object, len(objs))
-	for _, o := range objs {
-		objects <- o
+	res := newBatchResult(objs, errs)
+	if res.Failed > 0 {
+		return &BatchError{Result: res}
 	}
-	close(objects)
 
-	group, ctx := c.wp.GroupContext(ctx)
-	defer ctx.Done()
+	return nil
+}
 
-	for i := 0; i < c.wp.MaxWorkers(); i++ {
-		group.Submit(func() error {
-			for {
-				obj, ok := <-objects
-				if !ok {
-					break
-				}
+// newBatchResult returns the result of a batch whose objects were inserted with errs.
+func newBatchResult(objs []*objstoreentities.Object, errs []error) *collection.BatchResult {
+	res := &collection.BatchResult{Objects: make([]collection.BatchObjectResult, len(objs))}
 
-				err := c.insert(obj)
-				if err != nil {
-					return err
-				}
-			}
+	for i, obj := range objs {
+		if errs[i] != nil {
+			res.Objects[i].Err = errs[i]
+			res.Failed++
 
-			return nil
-		})
-	}
+			continue
+		}
 
-	err := group.Wait()
-	if err != nil {
-		return err
+		res.Objects[i].Id = obj.Id
 	}
 
-	return c.vectorIndex.Flush()
+	return res
 }
 
-// insert handles the actual insertion of the object both to the object storage and index.
-// it will also create an embedding for the data if vector is not specified.
+// insert handles the actual insertion of the object with its assigned id both to the object storage and index.
 func (c *Collection) insert(obj *objstoreentities.Object) error {
-	id, err := c.idCounter.fetchAndInc()
-	if err != nil {
+	if err := c.stores.PutObject(obj); err != nil {
 		return err
 	}
 
-	obj.Id = id
+	c.indexKeywords(obj)
+	c.indexFilterable(obj)
 
-	if err = c.stores.PutObject(obj); err != nil {
+	if err := c.vectorIndex.Insert(obj.Vector, obj.Id); err != nil {
 		return err
 	}
 
-	return c.vectorIndex.Insert(obj.Vector, obj.Id)
+	return c.insertNamedVectors(obj)
 }
@@ -5,6 +5,7 @@ import (
 	"Vectory/entities/collection"
 	"Vectory/entities/embeddings/hugging_face/text2vec"
 	"Vectory/entities/index"
+	"Vectory/entities/mappings"
 	"Vectory/entities/objstore"
 	bufio2 "bufio"
 	"context"
@@ -35,7 +36,7 @@ func TestCollection_Insert(t *testing.T) {
 			IndexType:   index.Hnsw,
 			DataType:    "text",
 			IndexParams: index.DefaultHnswParams,
-			Mappings:    []string{"title", "content"},
+			Mappings:    []mappings.Mapping{{Name: "title", Type: mappings.Text}, {Name: "content", Type: mappings.Text}},
 		})
 		require.NoError(t, err)
 
@@ -90,16 +91,39 @@ func TestCollection_Insert(t *testing.T) {
 
 			require.Error(t, c.Insert(ctx, &obj))
 		})
+
+		t.Run("insert with invalid property type", func(t *testing.T) {
+			obj := objstore.Object{
+				Properties: map[string]interface{}{
+					"title":   "Test",
+					"content": 10,
+				},
+				Vector: randomVector(128),
+			}
+
+			require.ErrorIs(t, c.Insert(ctx, &obj), ErrValidationFailed)
+		})
 	}
 
 	{
+		_, err := db.CreateCollection(ctx, &collection.Collection{
+			Name:         "test_collection2",
+			IndexType:    index.Hnsw,
+			EmbedderType: embeddings.FakeEmbedder,
+			DataType:     "text",
+			IndexParams:  index.DefaultHnswParams,
+			Mappings:     []mappings.Mapping{{Name: "title", Type: mappings.Text}, {Name: "price", Type: mappings.Float}},
+		})
+		require.ErrorIs(t, err, ErrValidationFailed)
+		require.ErrorContains(t, err, collection.ErrEmbeddedMappingMissing.Error())
+
 		c, err := db.CreateCollection(ctx, &collection.Collection{
 			Name:         "test_collection2",
 			IndexType:    index.Hnsw,
 			EmbedderType: embeddings.FakeEmbedder,
 			DataType:     "text",
 			IndexParams:  index.DefaultHnswParams,
-			Mappings:     []string{"title", "content"},
+			Mappings:     []mappings.Mapping{{Name: "title", Type: mappings.Text, Embed: true}, {Name: "content", Type: mappings.Text, Embed: true}},
 		})
 		require.NoError(t, err)
 
@@ -159,6 +183,81 @@ func TestCollection_Insert(t *testing.T) {
 
 }
 
+func TestCollection_InsertBatchWithResults(t *testing.T) {
+	ctx := context.Background()
+	filesPath := "./tmp_batch_results"
+	defer os.RemoveAll(filesPath)
+
+	db, err := Open(filesPath)
+	require.NoError(t, err)
+	defer db.Close()
+
+	c, err := db.CreateCollection(ctx, &collection.Collection{
+		Name:        "test_collection",
+		IndexType:   index.Hnsw,
+		DataType:    "text",
+		IndexParams: index.DefaultHnswParams,
+		Mappings:    []mappings.Mapping{{Name: "title", Type: mappings.Text}},
+	})
+	require.NoError(t, err)
+
+	dim := 32
+	objs := []*objstore.Object{
+		{Key: "a", Properties: map[string]interface{}{"title": "valid"}, Vector: randomVector(dim)},
+		{Properties: map[string]interface{}{"title": 10}, Vector: randomVector(dim)},
+		{Key: "a", Properties: map[string]interface{}{"title": "duplicate key"}, Vector: randomVector(dim)},
+		{Properties: map[string]interface{}{"title": "no vector"}},
+		{Key: "b", Properties: map[string]interface{}{"title": "valid"}, Vector: randomVector(dim)},
+	}
+
+	t.Run("invalid objects don't fail the others", func(t *testing.T) {
+		res, err := c.InsertBatchWithResults(ctx, objs)
+		require.NoError(t, err)
+		require.Len(t, res.Objects, len(objs))
+		require.Equal(t, 3, res.Failed)
+		require.True(t, res.Partial())
+
+		require.NoError(t, res.Objects[0].Err)
+		require.ErrorIs(t, res.Objects[1].Err, ErrValidationFailed)
+		require.ErrorIs(t, res.Objects[2].Err, ErrValidationFailed)
+		require.ErrorIs(t, res.Objects[3].Err, ErrMissingVectorAndEmbedder)
+		require.NoError(t, res.Objects[4].Err)
+
+		stored, err := c.GetByKeys([]string{"a", "b"})
+		require.NoError(t, err)
+		require.Len(t, stored, 2)
+		require.Equal(t, res.Objects[0].Id, stored[0].Id)
+		require.Equal(t, "valid", stored[0].Properties["title"])
+		require.Equal(t, res.Objects[4].Id, stored[1].Id)
+
+		size, err := c.GetSize()
+		require.NoError(t, err)
+		require.Equal(t, 2, size)
+	})
+
+	t.Run("failed insertions are reported", func(t *testing.T) {
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+
+		res, err := c.InsertBatchWithResults(canceled, []*objstore.Object{{Properties: map[string]interface{}{"title": "blah"}, Vector: randomVector(dim)}})
+		require.NoError(t, err)
+		require.Equal(t, 1, res.Failed)
+		require.False(t, res.Partial())
+		require.ErrorIs(t, res.Objects[0].Err, context.Canceled)
+
+		err = c.InsertBatch(canceled, []*objstore.Object{{Properties: map[string]interface{}{"title": "blah"}, Vector: randomVector(dim)}})
+		require.ErrorIs(t, err, ErrBatchPartiallyApplied)
+
+		var batchErr *BatchError
+		require.ErrorAs(t, err, &batchErr)
+		require.ErrorIs(t, batchErr.Result.Objects[0].Err, context.Canceled)
+
+		size, err := c.GetSize()
+		require.NoError(t, err)
+		require.Equal(t, 2, size)
+	})
+}
+
 func BenchmarkCollection_InsertBatch(b *testing.B) {
 	defer profile.Start(profile.CPUProfile, profile.ProfilePath("./profile")).Stop()
 	b.ResetTimer()
@@ -177,7 +276,7 @@ func BenchmarkCollection_InsertBatch(b *testing.B) {
 		IndexType:   index.Hnsw,
 		DataType:    "text",
 		IndexParams: index.DefaultHnswParams,
-		Mappings:    []string{"title", "content"},
+		Mappings:    []mappings.Mapping{{Name: "title", Type: mappings.Text, Embed: true}, {Name: "content", Type: mappings.Text, Embed: true}},
 	})
 	require.NoError(b, err)
 
@@ -226,7 +325,7 @@ func BenchmarkCollection_InsertBatch_WithEmbedder(b *testing.B) {
 		},
 		DataType:    "text",
 		IndexParams: index.DefaultHnswParams,
-		Mappings:    []string{"title", "content"},
+		Mappings:    []mappings.Mapping{{Name: "title", Type: mappings.Text, Embed: true}, {Name: "content", Type: mappings.Text, Embed: true}},
 	})
 	require.NoError(b, err)
 
@@ -267,7 +366,7 @@ func BenchmarkCollection_InsertBatch2(b *testing.B) {
 		IndexType:   index.Hnsw,
 		DataType:    "text",
 		IndexParams: index.DefaultHnswParams,
-		Mappings:    []string{"title", "content"},
+		Mappings:    []mappings.Mapping{{Name: "title", Type: mappings.Text, Embed: true}, {Name: "content", Type: mappings.Text, Embed: true}},
 	})
 	require.NoError(b, err)
 
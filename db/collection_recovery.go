@@ -0,0 +1,219 @@
+package db
+
+import (
+	"Vectory/db/core/index"
+	objstoreentities "Vectory/entities/objstore"
+	"fmt"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// beginInsert assigns ids to objs and logs their insertion to the journal.
+func (c *Collection) beginInsert(objs []*objstoreentities.Object) error {
+	first, err := c.idCounter.fetchAndAdd(uint64(len(objs)))
+	if err != nil {
+		return err
+	}
+
+	ids := make([]uint64, 0, len(objs))
+	for i, obj := range objs {
+		obj.Id = first + uint64(i)
+		ids = append(ids, obj.Id)
+	}
+
+	return c.journal.begin(ids)
+}
+
+// commitInserts flushes the indexes after objs were inserted with errs and commits their insertion,
+// the objects that failed are rolled back first. when it fails, the whole insertion is aborted.
+func (c *Collection) commitInserts(objs []*objstoreentities.Object, errs []error) error {
+	if err := c.flushIndexes(); err != nil {
+		return c.abort(err)
+	}
+
+	var failed []uint64
+	for i, obj := range objs {
+		if errs[i] != nil {
+			failed = append(failed, obj.Id)
+		}
+	}
+
+	if len(failed) > 0 { // logged as a delete so that the inserted objects are kept if it's interrupted
+		if err := c.journal.begin(failed); err != nil {
+			return c.abort(err)
+		}
+
+		if err := c.remove(failed); err != nil {
+			return c.abort(err)
+		}
+	}
+
+	if err := c.journal.commit(); err != nil {
+		return c.abort(err)
+	}
+
+	return nil
+}
+
+// abort rolls back the uncommitted operation of the journal after it failed with err, so that it's committed
+// before the next operation begins. when the rollback fails too, writes fail until the collection is reopened
+// and the operation is rolled back by recover. it returns err.
+func (c *Collection) abort(err error) error {
+	var rollbackErr error
+	for i := len(c.journal.replaced) - 1; i >= 0 && rollbackErr == nil; i-- {
+		rollbackErr = c.restore(c.journal.replaced[i])
+	}
+
+	if rollbackErr == nil {
+		rollbackErr = c.remove(c.journal.uncommitted)
+	}
+
+	if rollbackErr == nil {
+		rollbackErr = c.journal.commit()
+	}
+
+	if rollbackErr != nil {
+		logrus.WithField("collection", c.name).WithError(rollbackErr).Error("failed rolling back an operation")
+
+		c.failed = fmt.Errorf("%w: %s", ErrCollectionFailed, rollbackErr)
+	}
+
+	return err
+}
+
+// writable returns the error writes to the collection fail with, if any.
+func (c *Collection) writable() error {
+	if c.closed {
+		return ErrCollectionClosed
+	}
+
+	if c.failed != nil {
+		return c.failed
+	}
+
+	if c.bulk {
+		return ErrBulkImportRunning
+	}
+
+	return nil
+}
+
+// remove deletes the objects with ids from the stores and all indexes, and flushes the indexes.
+// ids that don't exist are ignored.
+func (c *Collection) remove(ids []uint64) error {
+	for _, id := range ids {
+		if err := c.stores.DeleteObject(id); err != nil {
+			return errors.Wrapf(err, "failed deleting %d from object store", id)
+		}
+
+		if c.keywordIndex != nil {
+			c.keywordIndex.Delete(id)
+		}
+
+		if c.filterIndex != nil {
+			c.filterIndex.Delete(id)
+		}
+
+		if err := c.vectorIndex.Delete(id); err != nil {
+			return errors.Wrapf(err, "failed deleting %d from vector index", id)
+		}
+
+		if err := c.deleteNamedVectors(id); err != nil {
+			return err
+		}
+	}
+
+	return c.flushIndexes()
+}
+
+// recover rolls back the operation that wasn't committed before the collection was closed, deleting the objects
+// it wrote or restoring the object it replaced, and then repairs the objects that diverged between the stores
+// and the vector indexes.
+func (c *Collection) recover() error {
+	op, err := c.journal.pending()
+	if err != nil {
+		return err
+	}
+
+	if op != nil {
+		if op.replaced != nil {
+			logrus.WithField("collection", c.name).Warnf("restoring object %d replaced by an uncommitted update", op.replaced.Id)
+
+			err = c.restore(op.replaced)
+		} else {
+			logrus.WithField("collection", c.name).Warnf("rolling back %d objects of an uncommitted operation", len(op.ids))
+
+			err = c.remove(op.ids)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if err = c.journal.commit(); err != nil {
+			return err
+		}
+	}
+
+	return c.repair()
+}
+
+// repair removes the objects that are missing from the stores or from any of the vector indexes,
+// so that every remaining object is fully present in all of them.
+func (c *Collection) repair() error {
+	ids, err := c.stores.ObjectsIds(0)
+	if err != nil {
+		return err
+	}
+
+	stored := make(map[uint64]struct{}, len(ids))
+	for _, id := range ids {
+		stored[id] = struct{}{}
+	}
+
+	indexes := []index.VectorIndex{c.vectorIndex}
+	for _, idx := range c.namedIndexes {
+		indexes = append(indexes, idx)
+	}
+
+	diverged := make(map[uint64]struct{})
+
+	for _, idx := range indexes {
+		indexed := make(map[uint64]struct{}, len(ids))
+
+		for _, id := range idx.Ids() {
+			indexed[id] = struct{}{}
+
+			if _, ok := stored[id]; !ok {
+				diverged[id] = struct{}{}
+			}
+		}
+
+		for _, id := range ids {
+			if _, ok := indexed[id]; !ok {
+				diverged[id] = struct{}{}
+			}
+		}
+	}
+
+	if len(diverged) == 0 {
+		return nil
+	}
+
+	logrus.WithField("collection", c.name).Warnf("removing %d objects that diverged between stores and indexes", len(diverged))
+
+	removed := make([]uint64, 0, len(diverged))
+	for id := range diverged {
+		removed = append(removed, id)
+	}
+
+	if err = c.journal.begin(removed); err != nil {
+		return err
+	}
+
+	if err = c.remove(removed); err != nil {
+		return err
+	}
+
+	return c.journal.commit()
+}
@@ -0,0 +1,220 @@
+package db
+
+import (
+	coreindex "Vectory/db/core/index"
+	"Vectory/entities/collection"
+	"Vectory/entities/index"
+	"Vectory/entities/mappings"
+	"Vectory/entities/objstore"
+	"Vectory/entities/search"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"os"
+	"testing"
+)
+
+func TestCollection_Recovery(t *testing.T) {
+	ctx := context.Background()
+	filesPath := "./tmp_recovery"
+	defer os.RemoveAll(filesPath)
+
+	cfg := collection.Collection{
+		Name:        "test_collection",
+		IndexType:   index.Hnsw,
+		DataType:    "text",
+		IndexParams: index.DefaultHnswParams,
+		Mappings:    []mappings.Mapping{{Name: "title", Type: mappings.Text}},
+	}
+
+	db, err := Open(filesPath)
+	require.NoError(t, err)
+
+	c, err := db.CreateCollection(ctx, &cfg)
+	require.NoError(t, err)
+
+	size, dim := 100, 32
+	objs := make([]*objstore.Object, 0, size)
+	for i := 0; i < size; i++ {
+		objs = append(objs, &objstore.Object{
+			Key:        fmt.Sprintf("doc-%d", i),
+			Properties: map[string]interface{}{"title": "blah"},
+			Vector:     randomVector(dim),
+		})
+	}
+
+	require.NoError(t, c.InsertBatch(ctx, objs))
+
+	// reopen simulates a crash by closing and reopening the database without committing the last operation
+	reopen := func(t *testing.T) *Collection {
+		require.NoError(t, db.Close())
+
+		db, err = Open(filesPath)
+		require.NoError(t, err)
+
+		c, err := db.GetCollection(ctx, cfg.Name)
+		require.NoError(t, err)
+
+		return c
+	}
+
+	requireSize := func(t *testing.T, c *Collection, expected int) {
+		size, err := c.GetSize()
+		require.NoError(t, err)
+		require.Equal(t, expected, size)
+		require.Len(t, c.vectorIndex.Ids(), expected)
+	}
+
+	t.Run("uncommitted insert is rolled back", func(t *testing.T) {
+		obj := &objstore.Object{Key: "partial", Properties: map[string]interface{}{"title": "blah"}, Vector: randomVector(dim)}
+
+		require.NoError(t, c.beginInsert([]*objstore.Object{obj}))
+		require.NoError(t, c.stores.PutObject(obj))
+
+		c = reopen(t)
+		requireSize(t, c, size)
+
+		res, err := c.GetByKeys([]string{"partial"})
+		require.NoError(t, err)
+		require.Empty(t, res)
+	})
+
+	t.Run("uncommitted delete is rolled forward", func(t *testing.T) {
+		require.NoError(t, c.journal.begin([]uint64{objs[0].Id}))
+		require.NoError(t, c.stores.DeleteObject(objs[0].Id))
+
+		c = reopen(t)
+		requireSize(t, c, size-1)
+
+		res, err := c.SemanticSearch(ctx, &objstore.Object{Vector: objs[0].Vector}, search.NewOptions(1), nil, nil)
+		require.NoError(t, err)
+		require.NotEqual(t, objs[0].Id, res.Objects[0].Id)
+	})
+
+	t.Run("diverged objects are removed", func(t *testing.T) {
+		// an index vertex without an object and without a vector
+		require.NoError(t, c.vectorIndex.Insert(randomVector(dim), 1000))
+
+		// an object without an index vertex
+		require.NoError(t, c.stores.PutObject(&objstore.Object{Id: 1001, Properties: map[string]interface{}{"title": "blah"}, Vector: randomVector(dim)}))
+
+		// an index vertex without an object
+		require.NoError(t, c.stores.DeleteObject(objs[1].Id))
+
+		// an object whose vertex is deleted
+		require.NoError(t, c.vectorIndex.Delete(objs[2].Id))
+		require.NoError(t, c.flushIndexes())
+
+		c = reopen(t)
+		requireSize(t, c, size-3)
+
+		res, err := c.Get([]uint64{objs[2].Id, 1001})
+		require.NoError(t, err)
+		require.Empty(t, res)
+
+		for _, obj := range objs[3:10] {
+			res, err := c.SemanticSearch(ctx, &objstore.Object{Vector: obj.Vector}, search.NewOptions(1), nil, nil)
+			require.NoError(t, err)
+			require.Equal(t, obj.Id, res.Objects[0].Id)
+		}
+	})
+
+	requireObject := func(t *testing.T, c *Collection, expected *objstore.Object) {
+		res, err := c.Get([]uint64{expected.Id})
+		require.NoError(t, err)
+		require.Equal(t, expected.Properties, res[0].Properties)
+		require.Equal(t, expected.Vector, res[0].Vector)
+
+		searchRes, err := c.SemanticSearch(ctx, &objstore.Object{Vector: expected.Vector}, search.NewOptions(1), nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, expected.Id, searchRes.Objects[0].Id)
+	}
+
+	t.Run("uncommitted update is rolled back", func(t *testing.T) {
+		stored, _, err := c.stores.GetObject(objs[10].Id)
+		require.NoError(t, err)
+
+		updated := &objstore.Object{Id: objs[10].Id, Key: objs[10].Key, Properties: map[string]interface{}{"title": "updated"}, Vector: randomVector(dim)}
+
+		require.NoError(t, c.journal.beginUpdate(stored))
+		require.NoError(t, c.stores.PutObject(updated))
+		require.NoError(t, c.vectorIndex.Update(updated.Vector, updated.Id))
+		require.NoError(t, c.flushIndexes())
+
+		c = reopen(t)
+		requireSize(t, c, size-3)
+		requireObject(t, c, objs[10])
+	})
+
+	t.Run("failed update is rolled back", func(t *testing.T) {
+		updated := &objstore.Object{Id: objs[11].Id, Properties: map[string]interface{}{"title": "updated"}, Vector: randomVector(dim)}
+
+		failing := &failingIndex{VectorIndex: c.vectorIndex, failures: 1}
+		c.vectorIndex = failing
+
+		// rolled back right away
+		require.ErrorIs(t, c.Update(ctx, updated), errInjected)
+		requireObject(t, c, objs[11])
+
+		// rolled back on recovery, writes fail until then
+		failing.failures = -1
+		require.ErrorIs(t, c.Update(ctx, updated), errInjected)
+		require.ErrorIs(t, c.Delete(objs[11].Id), ErrCollectionFailed)
+
+		c.vectorIndex = failing.VectorIndex
+		c = reopen(t)
+		requireSize(t, c, size-3)
+		requireObject(t, c, objs[11])
+	})
+
+	t.Run("failed delete is rolled forward", func(t *testing.T) {
+		failing := &failingIndex{VectorIndex: c.vectorIndex, failures: 1}
+		c.vectorIndex = failing
+
+		// rolled forward right away
+		require.ErrorIs(t, c.Delete(objs[size-1].Id), errInjected)
+		requireSize(t, c, size-4)
+
+		// rolled forward on recovery, writes fail until then
+		failing.failures = -1
+		require.ErrorIs(t, c.Delete(objs[size-2].Id), errInjected)
+		require.ErrorIs(t, c.Insert(ctx, &objstore.Object{Properties: map[string]interface{}{"title": "blah"}, Vector: randomVector(dim)}), ErrCollectionFailed)
+
+		c.vectorIndex = failing.VectorIndex
+		c = reopen(t)
+		requireSize(t, c, size-5)
+
+		require.NoError(t, c.Insert(ctx, &objstore.Object{Properties: map[string]interface{}{"title": "blah"}, Vector: randomVector(dim)}))
+		requireSize(t, c, size-4)
+
+		require.NoError(t, db.Close())
+	})
+}
+
+var errInjected = errors.New("injected failure")
+
+// failingIndex fails the deletes and updates of the wrapped index while it has failures left, forever when
+// they're negative
+type failingIndex struct {
+	coreindex.VectorIndex
+	failures int
+}
+
+func (i *failingIndex) Delete(id uint64) error {
+	if i.failures != 0 {
+		i.failures--
+		return errInjected
+	}
+
+	return i.VectorIndex.Delete(id)
+}
+
+func (i *failingIndex) Update(vector []float32, id uint64) error {
+	if i.failures != 0 {
+		i.failures--
+		return errInjected
+	}
+
+	return i.VectorIndex.Update(vector, id)
+}
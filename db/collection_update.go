@@ -1,16 +1,158 @@
 package db
 
-import objstoreentities "Vectory/entities/objstore"
+import (
+	objstoreentities "Vectory/entities/objstore"
+	"context"
+	"fmt"
+	"github.com/pkg/errors"
+	"reflect"
+)
 
-// Update updates obj in the collection.
-func (c *Collection) Update(obj *objstoreentities.Object) error {
+// Update updates obj in the collection, keeping its id.
+// nil properties, a nil vector, missing named vectors or an empty key keep the stored ones, while the key can't be changed.
+// when properties are replaced without a vector and the collection has an embedder, the object is re-embedded.
+func (c *Collection) Update(ctx context.Context, obj *objstoreentities.Object) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if c.closed {
-		return ErrCollectionClosed
+	if err := c.writable(); err != nil {
+		return err
+	}
+
+	return c.update(ctx, obj)
+}
+
+// update replaces the stored object with obj's id by obj, see Update.
+func (c *Collection) update(ctx context.Context, obj *objstoreentities.Object) error {
+	stored, found, err := c.stores.GetObject(obj.Id)
+	if err != nil {
+		return errors.Wrapf(err, "failed getting %d from object store", obj.Id)
+	}
+
+	if !found {
+		return validationError{err: ErrObjectDoesntExist}
+	}
+
+	if obj.Key == "" {
+		obj.Key = stored.Key
+	} else if obj.Key != stored.Key {
+		return fmt.Errorf("%w: %s", ErrValidationFailed, ErrKeyChanged)
+	}
+
+	if obj.Properties == nil {
+		obj.Properties = stored.Properties
+	} else if err = c.validateObjectMappings(obj); err != nil {
+		return err
+	}
+
+	if obj.Vector == nil {
+		if obj.Properties == nil || c.embedder == nil || reflect.DeepEqual(obj.Properties, stored.Properties) {
+			obj.Vector = stored.Vector
+		} else if err = c.embedObjectsIfNeeded(ctx, []*objstoreentities.Object{obj}); err != nil {
+			return err
+		}
+	}
+
+	if err = c.validateObjectVector(obj); err != nil {
+		return err
+	}
+
+	for name, vector := range stored.Vectors {
+		if _, ok := obj.Vectors[name]; !ok {
+			if obj.Vectors == nil {
+				obj.Vectors = make(map[string][]float32, len(stored.Vectors))
+			}
+
+			obj.Vectors[name] = vector
+		}
+	}
+
+	if err = c.validateObjectNamedVectors(obj); err != nil {
+		return err
+	}
+
+	if err = c.journal.beginUpdate(stored); err != nil {
+		return err
+	}
+
+	if err = c.apply(obj, stored); err != nil {
+		return c.abort(err)
+	}
+
+	if err = c.flushIndexes(); err != nil {
+		return c.abort(err)
+	}
+
+	if err = c.journal.commit(); err != nil {
+		return c.abort(err)
 	}
 
-	// TODO: handle race conditions
 	return nil
 }
+
+// apply writes obj over stored to the stores and indexes.
+func (c *Collection) apply(obj, stored *objstoreentities.Object) error {
+	if err := c.stores.PutObject(obj); err != nil {
+		return errors.Wrapf(err, "failed updating %d in object store", obj.Id)
+	}
+
+	c.indexKeywords(obj)
+	c.indexFilterable(obj)
+
+	if !isSameVector(obj.Vector, stored.Vector) {
+		if err := c.vectorIndex.Update(obj.Vector, obj.Id); err != nil {
+			return errors.Wrapf(err, "failed updating %d in vector index", obj.Id)
+		}
+	}
+
+	return c.updateNamedVectors(obj, stored)
+}
+
+// restore writes stored back to the stores and indexes after an update of it failed midway or wasn't committed. since the vector
+// indexes are updated after the object store, only the vectors that differ from the ones in the object store
+// may have been updated in their indexes.
+func (c *Collection) restore(stored *objstoreentities.Object) error {
+	current, found, err := c.stores.GetObject(stored.Id)
+	if err != nil {
+		return errors.Wrapf(err, "failed getting %d from object store", stored.Id)
+	}
+
+	if err = c.stores.PutObject(stored); err != nil {
+		return errors.Wrapf(err, "failed restoring %d in object store", stored.Id)
+	}
+
+	c.indexKeywords(stored)
+	c.indexFilterable(stored)
+
+	if !found || !isSameVector(current.Vector, stored.Vector) {
+		if err = c.vectorIndex.Update(stored.Vector, stored.Id); err != nil {
+			return errors.Wrapf(err, "failed restoring %d in vector index", stored.Id)
+		}
+	}
+
+	for name, idx := range c.namedIndexes {
+		if found && isSameVector(current.Vectors[name], stored.Vectors[name]) {
+			continue
+		}
+
+		if err = idx.Update(stored.Vectors[name], stored.Id); err != nil {
+			return errors.Wrapf(err, "failed restoring %d in %s vector index", stored.Id, name)
+		}
+	}
+
+	return c.flushIndexes()
+}
+
+func isSameVector(v1, v2 []float32) bool {
+	if len(v1) != len(v2) {
+		return false
+	}
+
+	for i := range v1 {
+		if v1[i] != v2[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,155 @@
+package db
+
+import (
+	"Vectory/entities/collection"
+	"Vectory/entities/filters"
+	"Vectory/entities/index"
+	"Vectory/entities/mappings"
+	"Vectory/entities/objstore"
+	"Vectory/entities/search"
+	"context"
+	"github.com/stretchr/testify/require"
+	"os"
+	"sync"
+	"testing"
+)
+
+func TestCollection_Update(t *testing.T) {
+	ctx := context.Background()
+	filesPath := "./tmp_update"
+
+	db, err := Open(filesPath)
+	require.NoError(t, err)
+	defer os.RemoveAll(filesPath)
+
+	c, err := db.CreateCollection(ctx, &collection.Collection{
+		Name:        "test_collection",
+		IndexType:   index.Hnsw,
+		DataType:    "text",
+		IndexParams: index.DefaultHnswParams,
+		Mappings:    []mappings.Mapping{{Name: "title", Type: mappings.Text}, {Name: "content", Type: mappings.Text, Filterable: true}},
+	})
+	require.NoError(t, err)
+
+	objs := make([]*objstore.Object, 0, 100)
+	for i := 0; i < 100; i++ {
+		objs = append(objs, &objstore.Object{
+			Properties: map[string]interface{}{
+				"title":   "Test",
+				"content": "blah",
+			},
+			Vector: randomVector(128),
+		})
+	}
+	require.NoError(t, c.InsertBatch(ctx, objs))
+
+	t.Run("update vector", func(t *testing.T) {
+		vec := randomVector(128)
+		require.NoError(t, c.Update(ctx, &objstore.Object{Id: 10, Vector: vec}))
+
+		res, err := c.Get([]uint64{10})
+		require.NoError(t, err)
+		require.Equal(t, vec, res[0].Vector)
+		require.Equal(t, objs[10].Properties, res[0].Properties)
+
+		searchRes, err := c.SemanticSearch(ctx, &objstore.Object{Vector: vec}, search.NewOptions(1), nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, uint64(10), searchRes.Objects[0].Id)
+	})
+
+	t.Run("update properties", func(t *testing.T) {
+		props := map[string]interface{}{
+			"title":   "Updated",
+			"content": "blah blah",
+		}
+		require.NoError(t, c.Update(ctx, &objstore.Object{Id: 20, Properties: props}))
+
+		res, err := c.Get([]uint64{20})
+		require.NoError(t, err)
+		require.Equal(t, props, res[0].Properties)
+		require.Equal(t, objs[20].Vector, res[0].Vector)
+	})
+
+	t.Run("update with invalid mappings", func(t *testing.T) {
+		err := c.Update(ctx, &objstore.Object{Id: 30, Properties: map[string]interface{}{"unknown": "blah"}})
+		require.ErrorIs(t, err, ErrValidationFailed)
+	})
+
+	t.Run("update deleted object", func(t *testing.T) {
+		require.NoError(t, c.Delete(40))
+		require.ErrorIs(t, c.Update(ctx, &objstore.Object{Id: 40, Vector: randomVector(128)}), ErrValidationFailed)
+	})
+
+	t.Run("failed update is restored", func(t *testing.T) {
+		c.vectorIndex = &failingIndex{VectorIndex: c.vectorIndex, failures: 1}
+		defer func() { c.vectorIndex = c.vectorIndex.(*failingIndex).VectorIndex }()
+
+		vec := randomVector(128)
+		props := map[string]interface{}{"title": "Failed", "content": "failed"}
+		require.ErrorIs(t, c.Update(ctx, &objstore.Object{Id: 30, Properties: props, Vector: vec}), errInjected)
+
+		res, err := c.Get([]uint64{30})
+		require.NoError(t, err)
+		require.Equal(t, objs[30].Properties, res[0].Properties)
+		require.Equal(t, objs[30].Vector, res[0].Vector)
+
+		filter := &filters.Filter{Operator: filters.Equal, Property: "content", Value: "failed"}
+		searchRes, err := c.SemanticSearch(ctx, &objstore.Object{Vector: vec}, search.NewOptions(1), filter, nil)
+		require.NoError(t, err)
+		require.Empty(t, searchRes.Objects)
+
+		searchRes, err = c.SemanticSearch(ctx, &objstore.Object{Vector: objs[30].Vector}, search.NewOptions(1), nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, uint64(30), searchRes.Objects[0].Id)
+
+		// writes go on after the update was restored
+		require.NoError(t, c.Update(ctx, &objstore.Object{Id: 30, Properties: props, Vector: vec}))
+	})
+
+	t.Run("concurrent updates, searches and deletes", func(t *testing.T) {
+		// goroutines can't fail the test, their errors are checked once they're done
+		var (
+			wg                                 sync.WaitGroup
+			updateErrs, searchErrs, deleteErrs = make(chan error, 50), make(chan error, 50), make(chan error, 50)
+		)
+
+		for i := 50; i < 100; i++ {
+			wg.Add(3)
+
+			go func(id uint64) {
+				defer wg.Done()
+				updateErrs <- c.Update(ctx, &objstore.Object{Id: id, Vector: randomVector(128)})
+			}(uint64(i))
+
+			go func() {
+				defer wg.Done()
+				_, err := c.SemanticSearch(ctx, &objstore.Object{Vector: randomVector(128)}, search.NewOptions(5), nil, nil)
+				searchErrs <- err
+			}()
+
+			go func(id uint64) {
+				defer wg.Done()
+				deleteErrs <- c.Delete(id)
+			}(uint64(i + 1))
+		}
+		wg.Wait()
+
+		close(updateErrs)
+		close(searchErrs)
+		close(deleteErrs)
+
+		for err := range updateErrs {
+			if err != nil { // the object was deleted first
+				require.ErrorIs(t, err, ErrValidationFailed)
+			}
+		}
+
+		for err := range searchErrs {
+			require.NoError(t, err)
+		}
+
+		for err := range deleteErrs {
+			require.NoError(t, err)
+		}
+	})
+}
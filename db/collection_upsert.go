@@ -0,0 +1,35 @@
+package db
+
+import (
+	objstoreentities "Vectory/entities/objstore"
+	"context"
+	"fmt"
+	"github.com/pkg/errors"
+)
+
+// Upsert updates the object with obj's key when it exists, see Update, and inserts obj otherwise.
+func (c *Collection) Upsert(ctx context.Context, obj *objstoreentities.Object) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.writable(); err != nil {
+		return err
+	}
+
+	if obj.Key == "" {
+		return fmt.Errorf("%w: %s", ErrValidationFailed, ErrMissingKey)
+	}
+
+	id, found, err := c.stores.GetId(obj.Key)
+	if err != nil {
+		return errors.Wrapf(err, "failed getting the id of %s from keys store", obj.Key)
+	}
+
+	if !found {
+		return c.insertOne(ctx, obj)
+	}
+
+	obj.Id = id
+
+	return c.update(ctx, obj)
+}
@@ -0,0 +1,131 @@
+package db
+
+import (
+	"Vectory/entities/collection"
+	"Vectory/entities/index"
+	"Vectory/entities/mappings"
+	"Vectory/entities/objstore"
+	"Vectory/entities/search"
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"os"
+	"testing"
+)
+
+func TestCollection_Keys(t *testing.T) {
+	ctx := context.Background()
+	filesPath := "./tmp_keys"
+	defer os.RemoveAll(filesPath)
+
+	cfg := collection.Collection{
+		Name:        "test_collection",
+		IndexType:   index.Hnsw,
+		DataType:    "text",
+		IndexParams: index.DefaultHnswParams,
+		Mappings:    []mappings.Mapping{{Name: "title", Type: mappings.Text}},
+	}
+
+	db, err := Open(filesPath)
+	require.NoError(t, err)
+
+	c, err := db.CreateCollection(ctx, &cfg)
+	require.NoError(t, err)
+
+	size, dim := 100, 32
+	objs := make([]*objstore.Object, 0, size)
+	for i := 0; i < size; i++ {
+		objs = append(objs, &objstore.Object{
+			Key:        fmt.Sprintf("doc-%d", i),
+			Properties: map[string]interface{}{"title": fmt.Sprintf("title %d", i)},
+			Vector:     randomVector(dim),
+		})
+	}
+
+	require.NoError(t, c.InsertBatch(ctx, objs))
+
+	t.Run("get by keys", func(t *testing.T) {
+		res, err := c.GetByKeys([]string{"doc-7", "missing", "doc-3"})
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		require.Equal(t, objs[7].Id, res[0].Id)
+		require.Equal(t, "doc-7", res[0].Key)
+		require.Equal(t, objs[3].Id, res[1].Id)
+
+		nearest, err := c.SemanticSearch(ctx, &objstore.Object{Vector: objs[5].Vector}, search.NewOptions(1), nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, "doc-5", nearest.Objects[0].Key)
+	})
+
+	t.Run("keys are unique", func(t *testing.T) {
+		err := c.Insert(ctx, &objstore.Object{Key: "doc-1", Properties: map[string]interface{}{"title": "blah"}, Vector: randomVector(dim)})
+		require.ErrorIs(t, err, ErrValidationFailed)
+
+		err = c.InsertBatch(ctx, []*objstore.Object{
+			{Key: "new", Properties: map[string]interface{}{"title": "blah"}, Vector: randomVector(dim)},
+			{Key: "new", Properties: map[string]interface{}{"title": "blah"}, Vector: randomVector(dim)},
+		})
+		require.ErrorIs(t, err, ErrValidationFailed)
+
+		res, err := c.GetByKeys([]string{"new"})
+		require.NoError(t, err)
+		require.Empty(t, res)
+
+		err = c.Update(ctx, &objstore.Object{Id: objs[0].Id, Key: "doc-2"})
+		require.ErrorIs(t, err, ErrValidationFailed)
+	})
+
+	t.Run("upsert", func(t *testing.T) {
+		updated := &objstore.Object{Key: "doc-4", Properties: map[string]interface{}{"title": "updated"}}
+		require.NoError(t, c.Upsert(ctx, updated))
+		require.Equal(t, objs[4].Id, updated.Id)
+
+		inserted := &objstore.Object{Key: "doc-100", Properties: map[string]interface{}{"title": "inserted"}, Vector: randomVector(dim)}
+		require.NoError(t, c.Upsert(ctx, inserted))
+
+		res, err := c.GetByKeys([]string{"doc-4", "doc-100"})
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		require.Equal(t, "updated", res[0].Properties["title"])
+		require.Equal(t, objs[4].Vector, res[0].Vector)
+		require.Equal(t, inserted.Id, res[1].Id)
+
+		size, err := c.GetSize()
+		require.NoError(t, err)
+		require.Equal(t, len(objs)+1, size)
+
+		err = c.Upsert(ctx, &objstore.Object{Properties: map[string]interface{}{"title": "blah"}, Vector: randomVector(dim)})
+		require.ErrorIs(t, err, ErrValidationFailed)
+	})
+
+	t.Run("delete by key", func(t *testing.T) {
+		require.NoError(t, c.DeleteByKey("doc-6"))
+		require.NoError(t, c.DeleteByKey("missing"))
+
+		res, err := c.GetByKeys([]string{"doc-6"})
+		require.NoError(t, err)
+		require.Empty(t, res)
+
+		// a deleted key can be reused
+		obj := &objstore.Object{Key: "doc-6", Properties: map[string]interface{}{"title": "again"}, Vector: randomVector(dim)}
+		require.NoError(t, c.Upsert(ctx, obj))
+		require.NotEqual(t, objs[6].Id, obj.Id)
+	})
+
+	t.Run("keys are restored on reopen", func(t *testing.T) {
+		require.NoError(t, db.Close())
+
+		db, err = Open(filesPath)
+		require.NoError(t, err)
+		defer db.Close()
+
+		c, err := db.GetCollection(ctx, cfg.Name)
+		require.NoError(t, err)
+
+		res, err := c.GetByKeys([]string{"doc-9", "doc-100"})
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		require.Equal(t, objs[9].Id, res[0].Id)
+		require.Equal(t, "inserted", res[1].Properties["title"])
+	})
+}
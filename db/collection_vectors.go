@@ -0,0 +1,159 @@
+package db
+
+import (
+	"Vectory/db/core/index"
+	"Vectory/db/core/index/distance"
+	"Vectory/entities/collection"
+	objstoreentities "Vectory/entities/objstore"
+	"context"
+	"fmt"
+	"github.com/pkg/errors"
+)
+
+// namedVectorsDir is the directory of the named vectors indexes in the collection's directory
+const namedVectorsDir = "vectors"
+
+// IndexPath returns the directory of the vector index of the collection with name in the database in filesPath,
+// or of the index of its named vector when vector isn't empty.
+func IndexPath(filesPath, name, vector string) string {
+	if vector == "" {
+		return fmt.Sprintf("%s/%s", filesPath, name)
+	}
+
+	return fmt.Sprintf("%s/%s/%s/%s", filesPath, name, namedVectorsDir, vector)
+}
+
+// validateObjectsNamedVectors checks that objs have exactly the named vectors of the collection's config.
+func (c *Collection) validateObjectsNamedVectors(objs []*objstoreentities.Object) error {
+	return validateObjects(objs, c.validateObjectNamedVectors)
+}
+
+// validateObjectNamedVectors checks that obj has exactly the named vectors of the collection's config.
+func (c *Collection) validateObjectNamedVectors(obj *objstoreentities.Object) error {
+	for name := range obj.Vectors {
+		if _, ok := c.namedIndexes[name]; !ok {
+			return fmt.Errorf("%w: %s %s", ErrValidationFailed, ErrUnknownNamedVector, name)
+		}
+	}
+
+	for name := range c.namedIndexes {
+		if len(obj.Vectors[name]) == 0 {
+			return fmt.Errorf("%w: object does not have vector %s", ErrValidationFailed, name)
+		}
+	}
+
+	return nil
+}
+
+// indexOf returns the index of the named vector name, or the index of the objects' vector when name is empty.
+func (c *Collection) indexOf(name string) index.VectorIndex {
+	if name == "" {
+		return c.vectorIndex
+	}
+
+	return c.namedIndexes[name]
+}
+
+// queryVector returns the vector of obj that is searched in the index of the named vector name.
+// the objects' vector is embedded when needed, while named vectors must be provided.
+func (c *Collection) queryVector(ctx context.Context, obj *objstoreentities.Object, name string) ([]float32, error) {
+	if name == "" {
+		if err := c.embedObjectsIfNeeded(ctx, []*objstoreentities.Object{obj}); err != nil {
+			return nil, err
+		}
+
+		if err := c.validateObjectVector(obj); err != nil {
+			return nil, err
+		}
+
+		return obj.Vector, nil
+	}
+
+	if _, ok := c.namedIndexes[name]; !ok {
+		return nil, fmt.Errorf("%w: %s %s", ErrValidationFailed, ErrUnknownNamedVector, name)
+	}
+
+	vector, ok := obj.Vectors[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: searched object does not have vector %s", ErrValidationFailed, name)
+	}
+
+	return vector, nil
+}
+
+// insertNamedVectors inserts the named vectors of obj to their indexes.
+func (c *Collection) insertNamedVectors(obj *objstoreentities.Object) error {
+	for name, idx := range c.namedIndexes {
+		if err := idx.Insert(obj.Vectors[name], obj.Id); err != nil {
+			return errors.Wrapf(err, "failed inserting %d to %s vector index", obj.Id, name)
+		}
+	}
+
+	return nil
+}
+
+// updateNamedVectors updates the named vectors of obj that differ from the ones of stored in their indexes.
+func (c *Collection) updateNamedVectors(obj, stored *objstoreentities.Object) error {
+	for name, idx := range c.namedIndexes {
+		if isSameVector(obj.Vectors[name], stored.Vectors[name]) {
+			continue
+		}
+
+		if err := idx.Update(obj.Vectors[name], obj.Id); err != nil {
+			return errors.Wrapf(err, "failed updating %d in %s vector index", obj.Id, name)
+		}
+	}
+
+	return nil
+}
+
+// deleteNamedVectors deletes the object with objId from the indexes of the named vectors.
+func (c *Collection) deleteNamedVectors(objId uint64) error {
+	for name, idx := range c.namedIndexes {
+		if err := idx.Delete(objId); err != nil {
+			return errors.Wrapf(err, "failed deleting %d from %s vector index", objId, name)
+		}
+	}
+
+	return nil
+}
+
+// distanceFunc returns the distance function of the index of the named vector name, or of the objects' vector
+// when name is empty, normalizing the vectors when the index does.
+func (c *Collection) distanceFunc(name string) (func(v1, v2 []float32) float32, error) {
+	params := c.config.IndexParams
+	for _, v := range c.config.NamedVectors {
+		if v.Name == name {
+			params = v.IndexParams
+		}
+	}
+
+	distanceType, err := collection.DistanceTypeOf(params)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed reading distance type")
+	}
+
+	f, normalize := distance.FromType(distanceType)
+	if !normalize {
+		return f, nil
+	}
+
+	return func(v1, v2 []float32) float32 {
+		return f(distance.Normalize(v1), distance.Normalize(v2))
+	}, nil
+}
+
+// flushIndexes flushes the WALs of all vector indexes of the collection.
+func (c *Collection) flushIndexes() error {
+	if err := c.vectorIndex.Flush(); err != nil {
+		return err
+	}
+
+	for _, idx := range c.namedIndexes {
+		if err := idx.Flush(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
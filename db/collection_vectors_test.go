@@ -0,0 +1,196 @@
+package db
+
+import (
+	"Vectory/entities/collection"
+	"Vectory/entities/distance"
+	"Vectory/entities/index"
+	"Vectory/entities/mappings"
+	"Vectory/entities/objstore"
+	"Vectory/entities/search"
+	"context"
+	"github.com/stretchr/testify/require"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestCollection_NamedVectors(t *testing.T) {
+	ctx := context.Background()
+	filesPath := "./tmp_named_vectors"
+	defer os.RemoveAll(filesPath)
+
+	titleParams := index.DefaultHnswParams
+	titleParams.DistanceType = distance.Cosine
+
+	cfg := collection.Collection{
+		Name:        "test_collection",
+		IndexType:   index.Hnsw,
+		DataType:    "text",
+		IndexParams: index.DefaultHnswParams,
+		Mappings:    []mappings.Mapping{{Name: "title", Type: mappings.Text}},
+		NamedVectors: []index.NamedVector{
+			{Name: "title", IndexType: index.Hnsw, IndexParams: titleParams},
+			{Name: "body", IndexType: index.DiskAnn, IndexParams: index.DiskAnnParams{
+				MaxDegree:       32,
+				ListSize:        100,
+				Alpha:           1.2,
+				MemoryIndexSize: 500,
+				DistanceType:    distance.Euclidean,
+			}},
+		},
+	}
+
+	db, err := Open(filesPath)
+	require.NoError(t, err)
+
+	t.Run("invalid named vectors", func(t *testing.T) {
+		invalid := cfg
+		invalid.Name = "invalid"
+
+		invalid.NamedVectors = []index.NamedVector{cfg.NamedVectors[0], cfg.NamedVectors[0]}
+		_, err := db.CreateCollection(ctx, &invalid)
+		require.ErrorIs(t, err, ErrValidationFailed)
+
+		invalid.NamedVectors = []index.NamedVector{{Name: "../title", IndexType: index.Hnsw, IndexParams: titleParams}}
+		_, err = db.CreateCollection(ctx, &invalid)
+		require.ErrorIs(t, err, ErrValidationFailed)
+
+		invalid.NamedVectors = []index.NamedVector{{Name: strings.Repeat("t", 33), IndexType: index.Hnsw, IndexParams: titleParams}}
+		_, err = db.CreateCollection(ctx, &invalid)
+		require.ErrorIs(t, err, ErrValidationFailed)
+	})
+
+	c, err := db.CreateCollection(ctx, &cfg)
+	require.NoError(t, err)
+
+	size, dim := 500, 32
+	objs := make([]*objstore.Object, 0, size)
+	for i := 0; i < size; i++ {
+		objs = append(objs, &objstore.Object{
+			Properties: map[string]interface{}{"title": "blah"},
+			Vector:     randomVector(dim),
+			Vectors:    map[string][]float32{"title": randomVector(16), "body": randomVector(64)},
+		})
+	}
+
+	require.NoError(t, c.InsertBatch(ctx, objs))
+
+	requireNearest := func(t *testing.T, c *Collection, name string) {
+		for _, obj := range objs[:20] {
+			q := &objstore.Object{Vectors: map[string][]float32{name: obj.Vectors[name]}}
+			opts := search.NewOptions(1)
+			opts.Vector = name
+
+			res, err := c.SemanticSearch(ctx, q, opts, nil, nil)
+			require.NoError(t, err)
+			require.Len(t, res.Objects, 1)
+			require.Equal(t, obj.Id, res.Objects[0].Id)
+			require.InDelta(t, 0, res.Objects[0].Distance, 1e-5)
+		}
+	}
+
+	t.Run("search named vectors", func(t *testing.T) {
+		requireNearest(t, c, "title")
+		requireNearest(t, c, "body")
+
+		opts := search.NewOptions(1)
+		opts.Vector = "title"
+		opts.IncludeVector = true
+
+		res, err := c.SemanticSearch(ctx, &objstore.Object{Vectors: objs[0].Vectors}, opts, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, objs[0].Vector, res.Objects[0].Vector)
+		require.Equal(t, objs[0].Vectors, res.Objects[0].Vectors)
+	})
+
+	t.Run("range search named vectors", func(t *testing.T) {
+		for _, name := range []string{"title", "body"} {
+			res, err := c.RangeSearch(ctx, &objstore.Object{Vectors: objs[0].Vectors}, 1e-5, 0, name)
+			require.NoError(t, err)
+			require.Equal(t, 1, res.Hits)
+			require.Equal(t, objs[0].Id, res.Objects[0].Id)
+		}
+
+		_, err := c.RangeSearch(ctx, &objstore.Object{Vectors: map[string][]float32{"summary": randomVector(8)}}, 1e-5, 0, "summary")
+		require.ErrorIs(t, err, ErrValidationFailed)
+	})
+
+	t.Run("objects must have all named vectors", func(t *testing.T) {
+		err := c.Insert(ctx, &objstore.Object{
+			Properties: map[string]interface{}{"title": "blah"},
+			Vector:     randomVector(dim),
+			Vectors:    map[string][]float32{"title": randomVector(16)},
+		})
+		require.ErrorIs(t, err, ErrValidationFailed)
+
+		err = c.Insert(ctx, &objstore.Object{
+			Properties: map[string]interface{}{"title": "blah"},
+			Vector:     randomVector(dim),
+			Vectors:    map[string][]float32{"title": randomVector(16), "body": randomVector(64), "summary": randomVector(8)},
+		})
+		require.ErrorIs(t, err, ErrValidationFailed)
+	})
+
+	t.Run("unknown or missing searched vector", func(t *testing.T) {
+		opts := search.NewOptions(1)
+		opts.Vector = "summary"
+
+		_, err := c.SemanticSearch(ctx, &objstore.Object{Vectors: map[string][]float32{"summary": randomVector(8)}}, opts, nil, nil)
+		require.ErrorIs(t, err, ErrValidationFailed)
+
+		opts.Vector = "title"
+		_, err = c.SemanticSearch(ctx, &objstore.Object{Vector: randomVector(dim)}, opts, nil, nil)
+		require.ErrorIs(t, err, ErrValidationFailed)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		title := randomVector(16)
+		require.NoError(t, c.Update(ctx, &objstore.Object{Id: objs[1].Id, Vectors: map[string][]float32{"title": title}}))
+
+		objs[1].Vectors["title"] = title
+
+		stored, err := c.Get([]uint64{objs[1].Id})
+		require.NoError(t, err)
+		require.Equal(t, objs[1].Vectors, stored[0].Vectors)
+		require.Equal(t, objs[1].Vector, stored[0].Vector)
+
+		requireNearest(t, c, "title")
+		requireNearest(t, c, "body")
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, c.Delete(objs[2].Id))
+
+		opts := search.NewOptions(1)
+		opts.Vector = "body"
+
+		res, err := c.SemanticSearch(ctx, &objstore.Object{Vectors: objs[2].Vectors}, opts, nil, nil)
+		require.NoError(t, err)
+		require.NotEqual(t, objs[2].Id, res.Objects[0].Id)
+
+		objs = append(objs[:2], objs[3:]...)
+	})
+
+	t.Run("reopen", func(t *testing.T) {
+		require.NoError(t, db.Close())
+
+		db, err = Open(filesPath)
+		require.NoError(t, err)
+		defer db.Close()
+
+		c, err := db.GetCollection(ctx, cfg.Name)
+		require.NoError(t, err)
+
+		restored, err := c.GetConfig()
+		require.NoError(t, err)
+		require.Len(t, restored.NamedVectors, 2)
+		require.Equal(t, "title", restored.NamedVectors[0].Name)
+
+		requireNearest(t, c, "title")
+		requireNearest(t, c, "body")
+
+		res, err := c.SemanticSearch(ctx, &objstore.Object{Vector: objs[0].Vector}, search.NewOptions(1), nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, objs[0].Id, res.Objects[0].Id)
+	})
+}
@@ -1,7 +1,7 @@
 package disk_ann
 
 import (
-	"math"
+	"encoding/binary"
 	"os"
 	"sort"
 )
@@ -22,7 +22,7 @@ type dal struct {
 }
 
 func newDal(path string) (*dal, error) {
-	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0666)
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0666)
 	if err != nil {
 		return nil, err
 	}
@@ -35,20 +35,32 @@ func newDal(path string) (*dal, error) {
 	return &d, nil
 }
 
-func (d *dal) newMemoryEmptyPage() *page {
+func openDal(path string) (*dal, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dal{file: file}, nil
+}
+
+func (d *dal) close() error {
+	return d.file.Close()
+}
+
+// newMemoryEmptyPage allocates a page spanning pages consecutive pages on disk
+func (d *dal) newMemoryEmptyPage(pages int) *page {
 	p := page{
-		data: make([]byte, pageSize),
+		data: make([]byte, pages*pageSize),
 	}
 
 	return &p
 }
 
-func (d *dal) readPage(pageNum int) (*page, error) {
-	p := d.newMemoryEmptyPage()
-
-	offset := int64(pageNum * pageSize)
+func (d *dal) readPage(pageNum int, pages int) (*page, error) {
+	p := d.newMemoryEmptyPage(pages)
 
-	_, err := d.file.ReadAt(p.data, offset)
+	_, err := d.file.ReadAt(p.data, int64(pageNum*pageSize))
 	if err != nil {
 		return nil, err
 	}
@@ -69,9 +81,9 @@ func (d *dal) writePage(p *page) error {
 	return nil
 }
 
-func (d *dal) getNextPage() int {
+func (d *dal) getNextPage(pages int) int {
 	curr := d.nextPage
-	d.nextPage++
+	d.nextPage += pages
 
 	return curr
 }
@@ -79,28 +91,131 @@ func (d *dal) getNextPage() int {
 /*
 Index disk layout:
   - Each page is of pageSize size.
-  - First page contains size's metadata: vectors dimensions, graph's size, graph's max degree, firstId, index size, s id.
-  - All other pages contain vertices, each vertex contains: objId, vector, neighbors.
-  - The number of vertices in each page is calculated by: floor(pageSize / (4 * (1 + dim + degree))).
-    ------------------------------------------------
+  - First page contains the index's metadata: vectors dimension, max degree, number of vertices and s id.
+  - Vertices are sorted by id and stored in blocks, a block is the smallest number of pages that can hold a vertex,
+    so a vertex never crosses a block boundary and can be located by its position in the sorted ids.
+  - The pages after the last block contain the sorted ids of all vertices, each followed by the vertex's objId.
+    --------------------------------------------------------
 
-| metadata	| vertices	| vertices	| vertices	|
-|			|			|			|			|
+| metadata	| vertices	| vertices	| vertices	| ids		|
+|			|			|			|			|			|
 
-	------------------------------------------------
+	--------------------------------------------------------
 */
+type layout struct {
+	dim       uint32
+	maxDegree uint32
+	size      uint64
+}
+
+// pagesPerBlock returns the number of pages in a block
+func (l layout) pagesPerBlock() int {
+	return (vertexSize(l.dim, l.maxDegree) + pageSize - 1) / pageSize
+}
+
+func (l layout) verticesPerBlock() int {
+	return l.pagesPerBlock() * pageSize / vertexSize(l.dim, l.maxDegree)
+}
+
+func (l layout) blocks() int {
+	return (int(l.size) + l.verticesPerBlock() - 1) / l.verticesPerBlock()
+}
+
+// vertexOffset returns the offset in the file of the vertex at position i of the sorted ids
+func (l layout) vertexOffset(i int) int64 {
+	block := i / l.verticesPerBlock()
+	pageNum := 1 + block*l.pagesPerBlock()
+
+	return int64(pageNum*pageSize + (i%l.verticesPerBlock())*vertexSize(l.dim, l.maxDegree))
+}
+
+// idsPageNum returns the first page of the sorted ids
+func (l layout) idsPageNum() int {
+	return 1 + l.blocks()*l.pagesPerBlock()
+}
+
+func (l layout) idsPages() int {
+	return (16*int(l.size) + pageSize - 1) / pageSize
+}
+
+func serializeMetadata(buff []byte, l layout, s uint64) int {
+	var offset int
+
+	binary.LittleEndian.PutUint32(buff[offset:], l.dim)
+	offset += 4
+
+	binary.LittleEndian.PutUint32(buff[offset:], l.maxDegree)
+	offset += 4
+
+	binary.LittleEndian.PutUint64(buff[offset:], l.size)
+	offset += 8
+
+	binary.LittleEndian.PutUint64(buff[offset:], s)
+	offset += 8
+
+	return offset
+}
+
+func deserializeMetadata(buff []byte) (layout, uint64) {
+	var (
+		offset int
+		l      layout
+	)
+
+	l.dim = binary.LittleEndian.Uint32(buff[offset:])
+	offset += 4
+
+	l.maxDegree = binary.LittleEndian.Uint32(buff[offset:])
+	offset += 4
+
+	l.size = binary.LittleEndian.Uint64(buff[offset:])
+	offset += 8
+
+	s := binary.LittleEndian.Uint64(buff[offset:])
+
+	return l, s
+}
+
+// writeIndex writes mi to a temporary file which replaces path once it is synced to disk
+func writeIndex(path string, mi *MemoryIndex) error {
+	tmp := path + ".tmp"
+
+	d, err := newDal(tmp)
+	if err != nil {
+		return err
+	}
+
+	if err = d.writeIndex(mi); err != nil {
+		d.close()
+		return err
+	}
+
+	if err = d.file.Sync(); err != nil {
+		d.close()
+		return err
+	}
+
+	if err = d.close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
 func (d *dal) writeIndex(mi *MemoryIndex) error {
+	l := layout{dim: mi.dim, maxDegree: mi.maxDegree, size: uint64(mi.Size())}
+
 	// writing metadata page
-	metadataPage := d.newMemoryEmptyPage()
+	metadataPage := d.newMemoryEmptyPage(1)
 	metadataPage.pageNum = metadataPageNum
-	mi.serializeMetadata(metadataPage.data)
+	serializeMetadata(metadataPage.data, l, mi.s)
 
 	err := d.writePage(metadataPage)
 	if err != nil {
 		return err
 	}
 
-	// writing vertices pages
+	// writing vertices blocks
 	sortedIds := make([]uint64, 0, len(mi.graph.vertices))
 
 	for id := range mi.graph.vertices {
@@ -111,76 +226,110 @@ func (d *dal) writeIndex(mi *MemoryIndex) error {
 		return sortedIds[i] < sortedIds[j]
 	})
 
-	numOfVerticesInPage := math.Floor(float64(pageSize / (4 * (1 + mi.dim + mi.maxDegree))))
-	numOfFullPages := len(sortedIds) / int(numOfVerticesInPage)
-	remainder := len(sortedIds) % int(numOfVerticesInPage)
-
-	var offset int
+	for i := 0; i < len(sortedIds); i += l.verticesPerBlock() {
+		p := d.newMemoryEmptyPage(l.pagesPerBlock())
+		p.pageNum = d.getNextPage(l.pagesPerBlock())
 
-	for i := 0; i < numOfFullPages; i++ {
-		p := d.newMemoryEmptyPage()
-		p.pageNum = d.getNextPage()
-
-		mi.graph.serializeVertices(p.data, sortedIds[offset:offset+int(numOfVerticesInPage)], mi.maxDegree)
+		var offset int
+		for j := i; j < i+l.verticesPerBlock() && j < len(sortedIds); j++ {
+			offset += mi.graph.vertices[sortedIds[j]].serialize(p.data[offset:], mi.maxDegree)
+		}
 
 		err = d.writePage(p)
 		if err != nil {
 			return err
 		}
+	}
 
-		offset += int(numOfVerticesInPage)
+	// writing sorted ids pages
+	if len(sortedIds) == 0 {
+		return nil
 	}
 
-	if remainder != 0 {
-		p := d.newMemoryEmptyPage()
-		p.pageNum = d.getNextPage()
+	p := d.newMemoryEmptyPage(l.idsPages())
+	p.pageNum = d.getNextPage(l.idsPages())
 
-		mi.graph.serializeVertices(p.data, sortedIds[offset:offset+remainder], mi.maxDegree)
+	for i, id := range sortedIds {
+		binary.LittleEndian.PutUint64(p.data[16*i:], id)
+		binary.LittleEndian.PutUint64(p.data[16*i+8:], mi.graph.vertices[id].objId)
+	}
 
-		err = d.writePage(p)
-		if err != nil {
-			return err
-		}
+	return d.writePage(p)
+}
+
+func (d *dal) readLayout() (layout, uint64, error) {
+	p, err := d.readPage(metadataPageNum, 1)
+	if err != nil {
+		return layout{}, 0, err
 	}
 
-	return nil
+	l, s := deserializeMetadata(p.data)
+
+	return l, s, nil
 }
 
-func (d *dal) readIndex() (*MemoryIndex, error) {
-	mi := MemoryIndex{graph: newGraph()}
+// readIds returns the sorted ids of all vertices and their objIds
+func (d *dal) readIds(l layout) ([]uint64, []uint64, error) {
+	if l.size == 0 {
+		return nil, nil, nil
+	}
+
+	p, err := d.readPage(l.idsPageNum(), l.idsPages())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ids, objIds := make([]uint64, l.size), make([]uint64, l.size)
+	for i := range ids {
+		ids[i] = binary.LittleEndian.Uint64(p.data[16*i:])
+		objIds[i] = binary.LittleEndian.Uint64(p.data[16*i+8:])
+	}
+
+	return ids, objIds, nil
+}
 
-	// reading metadata page
-	p, err := d.readPage(metadataPageNum)
+// readVertex reads the vertex at position i of the sorted ids
+func (d *dal) readVertex(l layout, i int) (*Vertex, error) {
+	buff := make([]byte, vertexSize(l.dim, l.maxDegree))
+
+	_, err := d.file.ReadAt(buff, l.vertexOffset(i))
 	if err != nil {
 		return nil, err
 	}
 
-	mi.deserializeMetadata(p.data)
+	v := Vertex{}
+	v.deserialize(buff, l.dim, l.maxDegree)
 
-	// reading vertices pages
-	numOfVerticesInPage := uint64(math.Floor(float64(pageSize / (4 * (1 + mi.dim + mi.maxDegree)))))
-	numOfFullPages := uint64(mi.size) / numOfVerticesInPage
-	remainder := uint64(mi.size) % numOfVerticesInPage
+	return &v, nil
+}
 
-	currId := mi.firstId
-	for i := 1; i <= int(numOfFullPages); i++ {
-		p, err = d.readPage(i)
-		if err != nil {
-			return nil, err
-		}
+func (d *dal) readIndex() (*MemoryIndex, error) {
+	l, s, err := d.readLayout()
+	if err != nil {
+		return nil, err
+	}
 
-		mi.graph.deserializeVertices(p.data, mi.dim, mi.maxDegree, numOfVerticesInPage, currId)
-		currId += numOfVerticesInPage
+	mi := MemoryIndex{
+		graph:     newGraph(),
+		s:         s,
+		dim:       l.dim,
+		maxDegree: l.maxDegree,
 	}
 
-	if remainder != 0 {
-		p, err = d.readPage(int(numOfFullPages) + 1)
+	// reading vertices blocks
+	for block := 0; block < l.blocks(); block++ {
+		p, err := d.readPage(1+block*l.pagesPerBlock(), l.pagesPerBlock())
 		if err != nil {
 			return nil, err
 		}
 
-		mi.graph.deserializeVertices(p.data, mi.dim, mi.maxDegree, remainder, currId)
-		currId += remainder
+		var offset int
+		for i := block * l.verticesPerBlock(); i < (block+1)*l.verticesPerBlock() && i < int(l.size); i++ {
+			v := Vertex{}
+			offset += v.deserialize(p.data[offset:], l.dim, l.maxDegree)
+
+			mi.graph.addVertex(&v)
+		}
 	}
 
 	return &mi, nil
@@ -1,14 +1,38 @@
 package disk_ann
 
 import (
+	"Vectory/db/core/index"
+	"Vectory/db/core/index/distance"
 	"Vectory/db/core/index/utils"
+	indexentities "Vectory/entities/index"
+	"Vectory/entities/search"
+	"container/heap"
 	"fmt"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"os"
+	"sort"
 	"sync"
-	"sync/atomic"
 )
 
-//var _ indexes.VectorIndex = &DiskAnn{}
+const (
+	// mergeThreshold is the number of read-only indexes that triggers merging them into the long-term index
+	mergeThreshold = 4
 
+	// flatSearchCutoff is the allow-list size under which a filtered search scans the allowed vertices
+	// instead of traversing the graphs.
+	flatSearchCutoff = 1000
+
+	// rangeSearchInitialK is the number of nearest neighbors first searched by a range search
+	rangeSearchInitialK = 64
+)
+
+var _ index.VectorIndex = &DiskAnn{}
+
+// DiskAnn is a FreshDiskANN style index. vectors are inserted to an in-memory Vamana graph, once it is full
+// it becomes read-only and is snapshotted to disk, and read-only indexes are merged in the background into
+// the long-term index which is searched from disk. operations on the write index are logged to a WAL
+// until it is snapshotted, and the complete snapshots are recorded in the manifest.
 type DiskAnn struct {
 	sync.RWMutex
 
@@ -16,87 +40,446 @@ type DiskAnn struct {
 	roIndexes []*MemoryIndex
 	ltIndex   *DiskIndex
 
-	deletedObjIds *sync.Map
+	deleted   map[uint64]struct{} // ids of deleted vertices
+	vertexIds map[uint64]uint64   // object id to the id of the vertex holding its current vector
 
 	distanceFunction func([]float32, []float32) float32
+	normalize        bool // vectors are normalized before being indexed or searched
 
-	listSize             int
-	distanceThreshold    float32
-	maxDegree            uint32
-	dim                  uint32
-	memoryIndexSizeLimit uint32
+	listSize        int
+	alpha           float32
+	maxDegree       uint32
+	dim             uint32
+	memoryIndexSize uint32
 
-	currId uint64
+	currId     uint64
+	filesPath  string
+	nextFileId int
+	wal        *wal
+	walSeqNum  uint64 // last WAL record contained in the read-only indexes
+	merging    bool
+	closed     bool
+	mergeWg    sync.WaitGroup
 }
 
-func NewDiskAnn() *DiskAnn {
+func NewDiskAnn(params indexentities.DiskAnnParams, filesPath string) (*DiskAnn, error) {
 	da := DiskAnn{
-		deletedObjIds:        &sync.Map{},
-		roIndexes:            nil,
-		currId:               1, // vertices start from id 1
-		memoryIndexSizeLimit: 0,
+		deleted:         map[uint64]struct{}{},
+		vertexIds:       map[uint64]uint64{},
+		listSize:        params.ListSize,
+		alpha:           params.Alpha,
+		maxDegree:       uint32(params.MaxDegree),
+		memoryIndexSize: uint32(params.MemoryIndexSize),
+		currId:          1, // vertices start from id 1
+		filesPath:       fmt.Sprintf("%s/%s", filesPath, "disk_ann"),
 	}
 
-	da.rwIndex = newMemoryIndex(da.distanceFunction, da.deletedObjIds, da.currId, da.maxDegree, da.dim)
+	da.distanceFunction, da.normalize = distance.FromType(params.DistanceType)
 
-	return &da
-}
+	if err := os.MkdirAll(da.filesPath, 0750); err != nil {
+		return nil, errors.Wrapf(err, "failed creating DiskANN directory at %s", da.filesPath)
+	}
 
-// Load loads the index from disk and recovers in-memory indexes in case of a crash
-func loadIndex(path string) {
+	if err := da.load(); err != nil {
+		return nil, err
+	}
+
+	return &da, nil
 }
 
 func (da *DiskAnn) Insert(vector []float32, objId uint64) error {
 	da.Lock()
-	if da.rwIndex.Size() == da.memoryIndexSizeLimit { // TODO: find a better way for this
-		da.rwIndex.ReadOnly()
+	defer da.Unlock()
 
-		go func() {
-			err := da.rwIndex.snapshot(fmt.Sprintf("./ro_%d.vctry", len(da.roIndexes)))
-			if err != nil {
-				// TODO: retry?
-			}
-		}()
+	return da.insert(vector, objId)
+}
+
+// Update inserts vector as a new vertex of objId, the vertex holding its previous vector is deleted
+func (da *DiskAnn) Update(vector []float32, objId uint64) error {
+	da.Lock()
+	defer da.Unlock()
 
-		da.roIndexes = append(da.roIndexes, da.rwIndex)
-		da.rwIndex = newMemoryIndex(da.distanceFunction, da.deletedObjIds, da.currId, da.maxDegree, da.dim)
+	if _, ok := da.vertexIds[objId]; !ok {
+		return ErrVertexNotFound
+	}
+
+	return da.insert(vector, objId)
+}
+
+func (da *DiskAnn) insert(vector []float32, objId uint64) error {
+	if da.rwIndex.Size() >= da.memoryIndexSize {
+		if err := da.rollover(); err != nil {
+			return err
+		}
+	}
+
+	if da.normalize {
+		vector = distance.Normalize(vector)
 	}
-	da.Unlock()
 
-	nextId := atomic.AddUint64(&da.currId, 1)
-	currId := nextId - 1 // after atomically incrementing we are guaranteed to have unique incrementing ids
+	v := Vertex{
+		id:     da.currId,
+		objId:  objId,
+		vector: vector,
+	}
+
+	if err := da.applyInsert(&v); err != nil {
+		return err
+	}
+
+	da.wal.insertVertex(&v)
+
+	return nil
+}
+
+// applyInsert inserts v to the write index and makes it the vertex of its object
+func (da *DiskAnn) applyInsert(v *Vertex) error {
+	if da.dim == 0 { // dimension is set by the first inserted vector
+		da.dim = uint32(len(v.vector))
+		da.rwIndex.dim = da.dim
+	}
+
+	if err := da.rwIndex.insert(v, da.listSize, da.alpha); err != nil {
+		return err
+	}
+
+	if v.id >= da.currId {
+		da.currId = v.id + 1
+	}
+
+	if prev, ok := da.vertexIds[v.objId]; ok {
+		da.deleted[prev] = struct{}{}
+	}
+
+	da.vertexIds[v.objId] = v.id
+
+	return nil
+}
 
-	err := da.rwIndex.insert(vector, da.listSize, da.distanceThreshold, currId, objId)
+// rollover snapshots the write index to disk and replaces it with a new one, the read-only indexes
+// are merged into the long-term index once there are mergeThreshold of them.
+// the WAL records contained in the snapshot are truncated once it is recorded in the manifest.
+func (da *DiskAnn) rollover() error {
+	if err := da.wal.flush(); err != nil {
+		return err
+	}
+
+	seqNum, err := da.wal.lastSeqNum()
 	if err != nil {
 		return err
 	}
 
+	path := fmt.Sprintf("%s/ro_%d.vctry", da.filesPath, da.nextFileId)
+
+	if err = da.rwIndex.snapshot(path); err != nil {
+		return errors.Wrap(err, "failed snapshotting DiskANN write index")
+	}
+
+	da.nextFileId++
+
+	da.rwIndex.ReadOnly()
+	da.roIndexes = append(da.roIndexes, da.rwIndex)
+	da.rwIndex = newMemoryIndex(da.distanceFunction, da.deleted, da.maxDegree, da.dim)
+	da.walSeqNum = seqNum
+
+	if err = da.writeManifest(); err != nil {
+		return errors.Wrap(err, "failed writing DiskANN manifest")
+	}
+
+	if err = da.wal.truncateUntil(seqNum); err != nil {
+		return err
+	}
+
+	if len(da.roIndexes) >= mergeThreshold && !da.merging && !da.closed {
+		da.merging = true
+		da.mergeWg.Add(1)
+
+		go func() {
+			defer da.mergeWg.Done()
+
+			if err := da.merge(); err != nil {
+				logrus.WithError(err).Error("failed merging DiskANN read-only indexes")
+			}
+		}()
+	}
+
 	return nil
 }
 
-func (da *DiskAnn) Delete(objId uint32) bool {
-	da.deletedObjIds.Store(objId, struct{}{})
+// Delete marks the vertex of objId as deleted, it is removed from the graphs once merged into the long-term index
+func (da *DiskAnn) Delete(objId uint64) error {
+	da.Lock()
+	defer da.Unlock()
+
+	if da.applyDelete(objId) {
+		da.wal.deleteObject(objId)
+	}
+
+	return nil
+}
+
+// applyDelete marks the vertex of objId as deleted and reports whether objId had a vertex
+func (da *DiskAnn) applyDelete(objId uint64) bool {
+	id, ok := da.vertexIds[objId]
+	if !ok {
+		return false
+	}
+
+	da.deleted[id] = struct{}{}
+	delete(da.vertexIds, objId)
 
 	return true
 }
 
-func (da *DiskAnn) Search(q []float32, k int) []utils.Element {
-	rwResults, _ := da.rwIndex.search(q, k, da.listSize, true)
+// Ids returns the ids of the objects that have a vertex in the index
+func (da *DiskAnn) Ids() []uint64 {
+	da.RLock()
+	defer da.RUnlock()
+
+	ids := make([]uint64, 0, len(da.vertexIds))
+	for objId := range da.vertexIds {
+		ids = append(ids, objId)
+	}
+
+	return ids
+}
+
+// Search returns the k nearest neighbors of q within the maximum distance of opts, opts.Ef overrides the list size.
+func (da *DiskAnn) Search(q []float32, opts search.Options) []utils.Element {
+	da.RLock()
+	defer da.RUnlock()
+
+	return withinDistance(da.search(da.prepareVector(q), &opts, nil), &opts)
+}
+
+// SearchWithAllowList returns the k nearest neighbors of q out of the objects in allowList.
+func (da *DiskAnn) SearchWithAllowList(q []float32, opts search.Options, allowList *utils.Bitmap) []utils.Element {
+	da.RLock()
+	defer da.RUnlock()
+
+	q = da.prepareVector(q)
+
+	if allowList.Len() <= flatSearchCutoff {
+		return withinDistance(da.flatSearch(q, opts.K, allowList), &opts)
+	}
+
+	return withinDistance(da.search(q, &opts, allowList), &opts)
+}
+
+// RangeSearch returns up to limit objects within radius of q sorted by their distance. the k nearest neighbors
+// are searched with a growing k until fewer than k of them are within radius or limit is reached.
+func (da *DiskAnn) RangeSearch(q []float32, radius float32, limit int) []utils.Element {
+	da.RLock()
+	defer da.RUnlock()
+
+	q = da.prepareVector(q)
+	opts := search.Options{K: rangeSearchInitialK, MaxDistance: &radius}
+
+	for {
+		if opts.K > limit {
+			opts.K = limit
+		}
+
+		res := withinDistance(da.search(q, &opts, nil), &opts)
+		if len(res) < opts.K || opts.K == limit {
+			return res
+		}
+
+		opts.K *= 2
+	}
+}
+
+// search searches every index for the k nearest neighbors of q and returns the k nearest of them all.
+// when allowList is not nil, only objects in it are returned.
+func (da *DiskAnn) search(q []float32, opts *search.Options, allowList *utils.Bitmap) []utils.Element {
+	accept := func(v *Vertex) bool {
+		_, deleted := da.deleted[v.id]
+
+		return !deleted && (allowList == nil || allowList.Contains(v.objId))
+	}
+
+	k := opts.K
+
+	listSize := da.listSize
+	if opts.Ef > 0 {
+		listSize = opts.Ef
+	}
+
+	if k > listSize {
+		listSize = k
+	}
+
+	res, err := da.rwIndex.search(q, k, listSize, accept)
+	if err != nil {
+		logrus.WithError(err).Error("failed searching DiskANN write index")
+	}
 
 	for _, roIndex := range da.roIndexes {
-		roResults, _ := roIndex.search(q, k, da.listSize, true)
-		rwResults = append(rwResults, roResults...)
+		roRes, err := roIndex.search(q, k, listSize, accept)
+		if err != nil {
+			logrus.WithError(err).Error("failed searching DiskANN read-only index")
+		}
+
+		res = append(res, roRes...)
+	}
+
+	if da.ltIndex != nil {
+		ltRes, err := da.ltIndex.search(q, k, listSize, accept)
+		if err != nil {
+			logrus.WithError(err).Error("failed searching DiskANN long-term index")
+		}
+
+		res = append(res, ltRes...)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Distance < res[j].Distance
+	})
+
+	if len(res) > k {
+		res = res[:k]
 	}
 
-	ltResults, _ := da.ltIndex.search(q, k, da.listSize, true)
+	return res
+}
 
-	rwResults = append(rwResults, ltResults...)
+// flatSearch calculates the distance of q from every object in allowList and returns the k nearest.
+func (da *DiskAnn) flatSearch(q []float32, k int, allowList *utils.Bitmap) []utils.Element {
+	nearestNeighbors := utils.NewMaxHeap(0)
+
+	for _, objId := range allowList.ToSlice() {
+		id, ok := da.vertexIds[objId]
+		if !ok {
+			continue
+		}
+
+		v, err := da.vertex(id)
+		if err != nil {
+			logrus.WithError(err).Errorf("failed reading DiskANN vertex %d", id)
+			continue
+		}
+
+		heap.Push(nearestNeighbors, utils.Element{Id: objId, Distance: da.distanceFunction(v.vector, q)})
+
+		if nearestNeighbors.Len() > k {
+			heap.Pop(nearestNeighbors)
+		}
+	}
 
-	results := utils.NewMinMaxHeapFromSlice(rwResults)
+	res := nearestNeighbors.Elements
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Distance < res[j].Distance
+	})
 
-	for results.Len() > k {
-		utils.PopMax(results)
+	return res
+}
+
+// withinDistance drops the elements of res, which are sorted by distance, beyond the maximum distance of opts
+func withinDistance(res []utils.Element, opts *search.Options) []utils.Element {
+	for i, e := range res {
+		if !opts.Within(e.Distance) {
+			return res[:i]
+		}
+	}
+
+	return res
+}
+
+// vertex returns the vertex with id from the index holding it
+func (da *DiskAnn) vertex(id uint64) (*Vertex, error) {
+	if v, ok := da.rwIndex.graph.vertices[id]; ok {
+		return v, nil
+	}
+
+	for _, roIndex := range da.roIndexes {
+		if v, ok := roIndex.graph.vertices[id]; ok {
+			return v, nil
+		}
+	}
+
+	if da.ltIndex == nil {
+		return nil, ErrVertexNotFound
+	}
+
+	return da.ltIndex.vertex(id)
+}
+
+func (da *DiskAnn) prepareVector(q []float32) []float32 {
+	if !da.normalize {
+		return q
+	}
+
+	return distance.Normalize(q)
+}
+
+// Pause flushes the WAL and blocks merges from replacing the index's files until Resume is called.
+func (da *DiskAnn) Pause() error {
+	da.RLock()
+
+	if err := da.wal.flush(); err != nil {
+		da.RUnlock()
+		return err
+	}
+
+	return nil
+}
+
+// Resume unblocks the merges blocked by Pause.
+func (da *DiskAnn) Resume() {
+	da.RUnlock()
+}
+
+// Compact rolls the write index over and merges all read-only indexes into the long-term index, which removes
+// the deleted vertices. it must not run concurrently with modifications of the index.
+func (da *DiskAnn) Compact() error {
+	da.Lock()
+	if da.rwIndex.Size() > 0 {
+		if err := da.rollover(); err != nil {
+			da.Unlock()
+			return err
+		}
+	}
+	da.Unlock()
+
+	// a running merge, e.g. started by the rollover, may not include all read-only indexes
+	da.mergeWg.Wait()
+
+	da.Lock()
+	if da.closed || (len(da.roIndexes) == 0 && len(da.deleted) == 0) {
+		da.Unlock()
+		return nil
+	}
+
+	da.merging = true
+	da.Unlock()
+
+	return da.merge()
+}
+
+func (da *DiskAnn) Flush() error {
+	return da.wal.flush()
+}
+
+// Close waits for a running merge, closes the long-term index and the WAL
+func (da *DiskAnn) Close() error {
+	da.Lock()
+	da.closed = true
+	da.Unlock()
+
+	da.mergeWg.Wait()
+
+	da.Lock()
+	defer da.Unlock()
+
+	if da.ltIndex != nil {
+		if err := da.ltIndex.close(); err != nil {
+			return err
+		}
+	}
+
+	if err := da.wal.flush(); err != nil {
+		return err
 	}
 
-	return results.Elements
+	return da.wal.close()
 }
@@ -0,0 +1,258 @@
+package disk_ann
+
+import (
+	"Vectory/db/core/index/distance"
+	"Vectory/db/core/index/utils"
+	"Vectory/entities/index"
+	"Vectory/entities/search"
+	"github.com/stretchr/testify/require"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDiskAnn(t *testing.T) {
+	filesPath := "./tmp_disk_ann"
+	defer os.RemoveAll(filesPath)
+
+	params := index.DefaultDiskAnnParams
+	params.MaxDegree = 32
+	params.MemoryIndexSize = 200
+
+	da, err := NewDiskAnn(params, filesPath)
+	require.NoError(t, err)
+	defer da.Close()
+
+	size, dim := 1100, 32
+	vectors := make([][]float32, size)
+	for i := range vectors {
+		vectors[i] = randomVector(uint32(dim))
+		require.NoError(t, da.Insert(vectors[i], uint64(i)))
+	}
+
+	da.mergeWg.Wait()
+
+	deleted := map[uint64]struct{}{}
+	for len(deleted) < size/10 {
+		id := uint64(rand.Intn(size))
+		deleted[id] = struct{}{}
+		require.NoError(t, da.Delete(id))
+	}
+
+	recall := func(t *testing.T) {
+		var found int
+		for i, v := range vectors {
+			if _, ok := deleted[uint64(i)]; ok {
+				continue
+			}
+
+			res := da.Search(v, search.NewOptions(1))
+			if len(res) > 0 && res[0].Id == uint64(i) {
+				found++
+			}
+		}
+
+		require.Greater(t, float64(found)/float64(size-len(deleted)), 0.95)
+	}
+
+	requireNoDeleted := func(t *testing.T) {
+		for _, v := range vectors[:100] {
+			for _, e := range da.Search(v, search.NewOptions(10)) {
+				require.NotContains(t, deleted, e.Id)
+			}
+		}
+	}
+
+	t.Run("read-only indexes are merged into the long-term index", func(t *testing.T) {
+		require.NotNil(t, da.ltIndex)
+		require.Equal(t, uint32(4*params.MemoryIndexSize), da.ltIndex.Size())
+		require.Len(t, da.roIndexes, 1)
+		require.Equal(t, uint32(100), da.rwIndex.Size())
+
+		files, err := filepath.Glob(filepath.Join(da.filesPath, "*.vctry"))
+		require.NoError(t, err)
+		require.Len(t, files, 2) // the long-term index and the read-only index that wasn't merged yet
+	})
+
+	t.Run("search", func(t *testing.T) {
+		recall(t)
+		requireNoDeleted(t)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		id := uint64(0)
+		for _, ok := deleted[id]; ok; _, ok = deleted[id] {
+			id++
+		}
+
+		vectors[id] = randomVector(uint32(dim))
+		require.NoError(t, da.Update(vectors[id], id))
+
+		res := da.Search(vectors[id], search.NewOptions(2))
+		require.Equal(t, id, res[0].Id)
+		require.NotEqual(t, id, res[1].Id)
+
+		require.ErrorIs(t, da.Update(vectors[id], uint64(size)), ErrVertexNotFound)
+	})
+
+	t.Run("search with allow list", func(t *testing.T) {
+		allowList := utils.NewBitmap()
+		for i := 0; i < size; i += 2 {
+			allowList.Add(uint64(i))
+		}
+
+		for _, v := range vectors[:100] {
+			res := da.SearchWithAllowList(v, search.NewOptions(5), allowList)
+			require.Len(t, res, 5)
+
+			for _, e := range res {
+				require.Zero(t, e.Id%2)
+				require.NotContains(t, deleted, e.Id)
+			}
+		}
+	})
+
+	t.Run("range search", func(t *testing.T) {
+		q := vectors[1]
+		all := da.Search(q, search.NewOptions(200))
+		radius := all[149].Distance
+
+		res := da.RangeSearch(q, radius, size)
+		require.GreaterOrEqual(t, len(res), 145)
+
+		for i, e := range res {
+			require.LessOrEqual(t, e.Distance, radius)
+			require.NotContains(t, deleted, e.Id)
+
+			if i > 0 {
+				require.LessOrEqual(t, res[i-1].Distance, e.Distance)
+			}
+		}
+
+		require.Len(t, da.RangeSearch(q, radius, 20), 20)
+	})
+
+	t.Run("deleted vertices are removed by merging", func(t *testing.T) {
+		for i := size; i < size+3*params.MemoryIndexSize; i++ {
+			vectors = append(vectors, randomVector(uint32(dim)))
+			require.NoError(t, da.Insert(vectors[i], uint64(i)))
+		}
+
+		da.mergeWg.Wait()
+
+		for id := range deleted {
+			_, ok := da.ltIndex.position(id + 1) // vertex ids start from 1 and were assigned in insertion order
+			require.False(t, ok)
+		}
+
+		recall(t)
+		requireNoDeleted(t)
+	})
+}
+
+func TestDiskIndex_LargeVertices(t *testing.T) {
+	path := "./lt_large.vctry"
+	defer os.Remove(path)
+
+	params := index.DefaultDiskAnnParams
+	mi := newMemoryIndex(distance.EuclideanDistance, map[uint64]struct{}{}, 16, 1500) // vertex size exceeds a page
+
+	for i := uint64(1); i <= 50; i++ {
+		require.NoError(t, mi.insert(&Vertex{id: i, objId: i, vector: randomVector(mi.dim)}, params.ListSize, params.Alpha))
+	}
+
+	require.NoError(t, writeIndex(path, mi))
+
+	di, err := openDiskIndex(path, mi.calculateDistance)
+	require.NoError(t, err)
+	defer di.close()
+
+	for id, v := range mi.graph.vertices {
+		dv, err := di.vertex(id)
+		require.NoError(t, err)
+		require.Equal(t, v, dv)
+
+		// random vectors of this dimension are almost equidistant so the graph may not be connected,
+		// but the disk index must find exactly what the memory index it was written from finds
+		expected, err := mi.search(v.vector, 1, params.ListSize, func(*Vertex) bool { return true })
+		require.NoError(t, err)
+
+		res, err := di.search(v.vector, 1, params.ListSize, func(*Vertex) bool { return true })
+		require.NoError(t, err)
+		require.Equal(t, expected, res)
+	}
+}
+
+func TestDiskAnn_Restore(t *testing.T) {
+	filesPath := "./tmp_disk_ann_restore"
+	defer os.RemoveAll(filesPath)
+
+	params := index.DefaultDiskAnnParams
+	params.MaxDegree = 32
+	params.MemoryIndexSize = 200
+
+	da, err := NewDiskAnn(params, filesPath)
+	require.NoError(t, err)
+
+	size, dim := 1100, 32
+	vectors := make([][]float32, size)
+	for i := range vectors {
+		vectors[i] = randomVector(uint32(dim))
+		require.NoError(t, da.Insert(vectors[i], uint64(i)))
+	}
+
+	da.mergeWg.Wait()
+
+	for i := 0; i < size; i += 7 {
+		require.NoError(t, da.Delete(uint64(i)))
+	}
+
+	require.NoError(t, da.Update(randomVector(uint32(dim)), 1))
+	require.NoError(t, da.Update(randomVector(uint32(dim)), uint64(size-2)))
+	require.NoError(t, da.Flush())
+
+	first, err := da.wal.f.FirstIndex()
+	require.NoError(t, err)
+	require.Equal(t, da.walSeqNum, first, "WAL records contained in the read-only indexes are truncated")
+
+	expectedResults := make([][]utils.Element, 0, 100)
+	for _, v := range vectors[:100] {
+		expectedResults = append(expectedResults, da.Search(v, search.NewOptions(10)))
+	}
+
+	requireSameIndex := func(t *testing.T, expected, actual *DiskAnn) {
+		require.Equal(t, expected.vertexIds, actual.vertexIds)
+		require.Equal(t, expected.deleted, actual.deleted)
+		require.Equal(t, expected.currId, actual.currId)
+		require.Equal(t, expected.dim, actual.dim)
+		require.Equal(t, expected.rwIndex.graph, actual.rwIndex.graph)
+		require.Len(t, actual.roIndexes, len(expected.roIndexes))
+		require.Equal(t, expected.ltIndex.ids, actual.ltIndex.ids)
+
+		for i, v := range vectors[:100] {
+			require.Equal(t, expectedResults[i], actual.Search(v, search.NewOptions(10)))
+		}
+	}
+
+	t.Run("restore after crash", func(t *testing.T) {
+		stray := filepath.Join(da.filesPath, "ro_99.vctry")
+		require.NoError(t, os.WriteFile(stray, []byte("unfinished snapshot"), 0640))
+
+		restored, err := NewDiskAnn(params, filesPath)
+		require.NoError(t, err)
+
+		requireSameIndex(t, da, restored)
+		require.NoFileExists(t, stray)
+	})
+
+	t.Run("restore after close", func(t *testing.T) {
+		require.NoError(t, da.Close())
+
+		restored, err := NewDiskAnn(params, filesPath)
+		require.NoError(t, err)
+		defer restored.Close()
+
+		requireSameIndex(t, da, restored)
+	})
+}
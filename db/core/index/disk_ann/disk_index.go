@@ -1,11 +1,104 @@
 package disk_ann
 
-import "Vectory/db/core/index/utils"
+import (
+	"Vectory/db/core/index/utils"
+	"sort"
+)
 
+// beamWidth is the number of candidates expanded in every round of a search over the disk index
+const beamWidth = 4
+
+var _ graphReader = &DiskIndex{}
+
+// DiskIndex is the long-term index, its graph is read from disk while searching and only the sorted
+// vertices ids and their objIds are held in memory.
 type DiskIndex struct {
-	dal *dal
+	dal               *dal
+	layout            layout
+	ids               []uint64
+	objIds            []uint64
+	s                 uint64
+	calculateDistance func([]float32, []float32) float32
+	path              string
+}
+
+func openDiskIndex(path string, distFunc func([]float32, []float32) float32) (*DiskIndex, error) {
+	d, err := openDal(path)
+	if err != nil {
+		return nil, err
+	}
+
+	l, s, err := d.readLayout()
+	if err != nil {
+		d.close()
+		return nil, err
+	}
+
+	ids, objIds, err := d.readIds(l)
+	if err != nil {
+		d.close()
+		return nil, err
+	}
+
+	return &DiskIndex{
+		dal:               d,
+		layout:            l,
+		ids:               ids,
+		objIds:            objIds,
+		s:                 s,
+		calculateDistance: distFunc,
+		path:              path,
+	}, nil
+}
+
+func (di *DiskIndex) entrypoint() uint64 {
+	return di.s
+}
+
+func (di *DiskIndex) vertex(id uint64) (*Vertex, error) {
+	i, ok := di.position(id)
+	if !ok {
+		return nil, ErrVertexNotFound
+	}
+
+	return di.dal.readVertex(di.layout, i)
+}
+
+// position returns the position of id in the sorted ids
+func (di *DiskIndex) position(id uint64) (int, bool) {
+	i := sort.Search(len(di.ids), func(i int) bool {
+		return di.ids[i] >= id
+	})
+
+	return i, i < len(di.ids) && di.ids[i] == id
+}
+
+func (di *DiskIndex) Size() uint32 {
+	return uint32(len(di.ids))
+}
+
+func (di *DiskIndex) search(q []float32, k int, listSize int, accept func(*Vertex) bool) ([]utils.Element, error) {
+	if di.Size() == 0 {
+		return nil, nil
+	}
+
+	res, _, err := greedySearch(di, di.calculateDistance, q, k, listSize, beamWidth, accept)
+
+	return res, err
+}
+
+// load reads the whole graph to memory
+func (di *DiskIndex) load() (*MemoryIndex, error) {
+	mi, err := di.dal.readIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	mi.calculateDistance = di.calculateDistance
+
+	return mi, nil
 }
 
-func (di *DiskIndex) search(q []float32, k int, listSize int, onlySearch bool) ([]utils.Element, []utils.Element) {
-	return nil, nil
+func (di *DiskIndex) close() error {
+	return di.dal.close()
 }
@@ -21,44 +21,33 @@ func (g *Graph) addVertex(v *Vertex) {
 	g.vertices[v.id] = v
 }
 
-func (g *Graph) serializeVertices(buff []byte, ids []uint64, maxDegree uint32) int {
-	var offset int
-
-	for _, id := range ids {
-		v := g.vertices[id]
-		n := v.serialize(buff[offset:], maxDegree)
-		offset += n
-	}
-
-	return offset
-}
-
-func (g *Graph) deserializeVertices(buff []byte, dim uint32, maxDegree uint32, numberOfVertices uint64, currId uint64) int {
-	var offset int
-
-	for i := 0; i < int(numberOfVertices); i++ {
-		v := Vertex{}
-		v.id = currId + uint64(i)
-		n := v.deserialize(buff[offset:], dim, maxDegree)
-		g.vertices[v.id] = &v
-
-		offset += n
-	}
-
-	return offset
-}
-
 type Vertex struct {
 	id        uint64
-	objId     uint32
+	objId     uint64
 	neighbors []uint64
 	vector    []float32
 }
 
+// vertexSize returns the size of a serialized vertex, every vertex takes the same size on disk
+// regardless of its degree so it can be located by its position.
+func vertexSize(dim, maxDegree uint32) int {
+	return 8 + 8 + 4 + 4*int(dim) + 8*int(maxDegree)
+}
+
+/*
+vertex layout = [id uint64, objId uint64, degree uint32, vector dim * float32, neighbors maxDegree * uint64]
+neighbors beyond the vertex's degree are zero padded.
+*/
 func (v *Vertex) serialize(buff []byte, maxDegree uint32) int {
 	var offset int
 
-	binary.LittleEndian.PutUint32(buff[offset:], v.objId)
+	binary.LittleEndian.PutUint64(buff[offset:], v.id)
+	offset += 8
+
+	binary.LittleEndian.PutUint64(buff[offset:], v.objId)
+	offset += 8
+
+	binary.LittleEndian.PutUint32(buff[offset:], uint32(len(v.neighbors)))
 	offset += 4
 
 	for _, float := range v.vector {
@@ -72,10 +61,9 @@ func (v *Vertex) serialize(buff []byte, maxDegree uint32) int {
 		offset += 8
 	}
 
-	// TODO: zero padding (needed?)
 	for i := len(v.neighbors); i < int(maxDegree); i++ {
-		binary.LittleEndian.PutUint32(buff[offset:], 0)
-		offset += 4
+		binary.LittleEndian.PutUint64(buff[offset:], 0)
+		offset += 8
 	}
 
 	return offset
@@ -84,7 +72,13 @@ func (v *Vertex) serialize(buff []byte, maxDegree uint32) int {
 func (v *Vertex) deserialize(buff []byte, dim uint32, maxDegree uint32) int {
 	var offset int
 
-	v.objId = binary.LittleEndian.Uint32(buff[offset:])
+	v.id = binary.LittleEndian.Uint64(buff[offset:])
+	offset += 8
+
+	v.objId = binary.LittleEndian.Uint64(buff[offset:])
+	offset += 8
+
+	degree := int(binary.LittleEndian.Uint32(buff[offset:]))
 	offset += 4
 
 	v.vector = make([]float32, 0, int(dim))
@@ -96,20 +90,12 @@ func (v *Vertex) deserialize(buff []byte, dim uint32, maxDegree uint32) int {
 		v.vector = append(v.vector, math.Float32frombits(floatAsUint32))
 	}
 
-	// TODO: can be optimized for allocations if we store v's degree on disk and then allocate its neighbors size accordingly
-	var remainder int
-
-	for i := 0; i < int(maxDegree); i++ {
-		n := binary.LittleEndian.Uint64(buff[offset:])
-		offset += 4
-
-		if n == 0 { // no more neighbors
-			remainder = int(maxDegree) - i - 1 // paddings remainder
-			break
-		}
+	v.neighbors = make([]uint64, 0, degree)
 
-		v.neighbors = append(v.neighbors, n)
+	for i := 0; i < degree; i++ {
+		v.neighbors = append(v.neighbors, binary.LittleEndian.Uint64(buff[offset:]))
+		offset += 8
 	}
 
-	return offset + remainder*4
+	return offset + 8*(int(maxDegree)-degree)
 }
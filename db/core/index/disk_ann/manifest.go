@@ -0,0 +1,98 @@
+package disk_ann
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+const manifestFileName = "manifest.json"
+
+// manifest records the complete files of the index, every file that isn't in the manifest is leftover of
+// a snapshot or a merge that didn't finish. the WAL records up to WalSeqNum are contained in the read-only indexes.
+type manifest struct {
+	LongTerm     string   `json:"long_term,omitempty"`
+	ReadOnly     []string `json:"read_only"`
+	Deleted      []uint64 `json:"deleted"`
+	Dim          uint32   `json:"dim"`
+	NextVertexId uint64   `json:"next_vertex_id"`
+	NextFileId   int      `json:"next_file_id"`
+	WalSeqNum    uint64   `json:"wal_seq_num"`
+}
+
+// writeManifest records the current state of the index, it must be called while holding the index lock
+func (da *DiskAnn) writeManifest() error {
+	m := manifest{
+		ReadOnly:     make([]string, 0, len(da.roIndexes)),
+		Deleted:      make([]uint64, 0, len(da.deleted)),
+		Dim:          da.dim,
+		NextVertexId: da.currId,
+		NextFileId:   da.nextFileId,
+		WalSeqNum:    da.walSeqNum,
+	}
+
+	if da.ltIndex != nil {
+		m.LongTerm = filepath.Base(da.ltIndex.path)
+	}
+
+	for _, roIndex := range da.roIndexes {
+		m.ReadOnly = append(m.ReadOnly, filepath.Base(roIndex.snapshotPath))
+	}
+
+	for id := range da.deleted {
+		m.Deleted = append(m.Deleted, id)
+	}
+
+	sort.Slice(m.Deleted, func(i, j int) bool {
+		return m.Deleted[i] < m.Deleted[j]
+	})
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(da.filesPath, manifestFileName)
+	tmp := path + ".tmp"
+
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0640)
+	if err != nil {
+		return err
+	}
+
+	if _, err = f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err = f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
+// readManifest returns the manifest of the index, or nil if it was never written
+func readManifest(filesPath string) (*manifest, error) {
+	b, err := os.ReadFile(filepath.Join(filesPath, manifestFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var m manifest
+	if err = json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
@@ -3,16 +3,16 @@ package disk_ann
 import (
 	"Vectory/db/core/index/utils"
 	"container/heap"
-	"encoding/binary"
-	"sync"
 )
 
+var _ graphReader = &MemoryIndex{}
+
+// MemoryIndex is a Vamana graph held in memory. the write index of DiskAnn is a MemoryIndex, once it reaches
+// its size limit it becomes read-only and is snapshotted to disk until it is merged into the long-term index.
 type MemoryIndex struct {
-	sync.RWMutex
 	graph             *Graph
 	calculateDistance func([]float32, []float32) float32
-	deletedObjIds     *sync.Map
-	initialInsertion  *sync.Once
+	deleted           map[uint64]struct{}
 
 	// starting point
 	s uint64
@@ -23,22 +23,16 @@ type MemoryIndex struct {
 	// max vertex degree
 	maxDegree uint32
 
-	// the first vertex id that was inserted into the size
-	firstId uint64
-
 	readOnly     bool
-	size         uint32 // immutable size once the index is snapshot
 	snapshotPath string
 }
 
 func newMemoryIndex(distFunc func([]float32, []float32) float32,
-	deletedObjIds *sync.Map, firstId uint64, maxDegree uint32, dim uint32) *MemoryIndex {
+	deleted map[uint64]struct{}, maxDegree uint32, dim uint32) *MemoryIndex {
 	mi := MemoryIndex{
 		graph:             newGraph(),
 		calculateDistance: distFunc,
-		deletedObjIds:     deletedObjIds,
-		initialInsertion:  &sync.Once{},
-		firstId:           firstId,
+		deleted:           deleted,
 		maxDegree:         maxDegree,
 		dim:               dim,
 		readOnly:          false,
@@ -47,137 +41,85 @@ func newMemoryIndex(distFunc func([]float32, []float32) float32,
 	return &mi
 }
 
-// TODO: beam search support as well
-func (mi *MemoryIndex) search(q []float32, k int, listSize int, onlySearch bool) ([]utils.Element, []utils.Element) {
-	// TODO: locking
-	sVertex := mi.graph.vertices[mi.s]
-	e := utils.Element{
-		Id:       mi.s,
-		Distance: mi.calculateDistance(sVertex.vector, q),
-	}
-
-	resultSet := utils.NewMinMaxHeapFromSlice([]utils.Element{e})
-	visited := map[uint32]struct{}{}
-
-	var candidatesVisited []utils.Element
-
-	for resultSet.Len() != 0 {
-		min := utils.Pop(resultSet).(utils.Element)
-
-		if _, ok := visited[uint32(min.Id)]; ok {
-			continue
-		}
-
-		visited[uint32(min.Id)] = struct{}{}
-
-		minVertex := mi.graph.vertices[min.Id]
-
-		// add non-deleted vertices to the result
-		if _, ok := mi.deletedObjIds.Load(minVertex.objId); !ok {
-			candidatesVisited = append(candidatesVisited, min)
-		}
-
-		for _, n := range minVertex.neighbors {
-			nVertex := mi.graph.vertices[n]
-
-			e = utils.Element{
-				Id:       n,
-				Distance: mi.calculateDistance(nVertex.vector, q),
-			}
-
-			utils.Push(resultSet, e)
+func (mi *MemoryIndex) entrypoint() uint64 {
+	return mi.s
+}
 
-			for resultSet.Len() > listSize {
-				utils.PopMax(resultSet)
-			}
-		}
+func (mi *MemoryIndex) vertex(id uint64) (*Vertex, error) {
+	v, ok := mi.graph.vertices[id]
+	if !ok {
+		return nil, ErrVertexNotFound
 	}
 
-	// K-NN is returned when search is performed
-	if onlySearch {
-		candidatesHeap := utils.NewMinHeapFromSliceDeep(candidatesVisited, len(candidatesVisited))
-
-		knn := make([]utils.Element, 0, k)
-		for i := 0; i < k && candidatesHeap.Len() > 0; i++ {
-			knn = append(knn, heap.Pop(candidatesHeap).(utils.Element))
-		}
+	return v, nil
+}
 
-		return knn, nil
+func (mi *MemoryIndex) search(q []float32, k int, listSize int, accept func(*Vertex) bool) ([]utils.Element, error) {
+	if mi.Size() == 0 {
+		return nil, nil
 	}
 
-	return nil, candidatesVisited
-}
+	res, _, err := greedySearch(mi, mi.calculateDistance, q, k, listSize, 1, accept)
 
-func (mi *MemoryIndex) insert(v []float32, listSize int, distanceThreshold float32, currId, dataId uint64) error {
-	mi.Lock() // TODO: optimize locking
-	defer mi.Unlock()
+	return res, err
+}
 
+// insert adds v to the graph, v is connected to the pruned set of vertices visited while searching for it
+// and each of them is connected back to v.
+func (mi *MemoryIndex) insert(v *Vertex, listSize int, alpha float32) error {
 	if mi.readOnly {
 		return ErrReadOnlyIndex
 	}
 
-	if len(v) != int(mi.dim) {
+	if len(v.vector) != int(mi.dim) {
 		return ErrVectorDimensions
 	}
 
-	vertex := Vertex{
-		id: currId,
-		//objId:  dataId,
-		vector: v,
-	}
-
-	var first bool
-	mi.initialInsertion.Do(func() {
-		if mi.s == 0 {
-			mi.s = vertex.id
-		}
-
-		if mi.Size() == 0 { // first insertion
-			mi.graph.addVertex(&vertex)
-			first = true
-		}
-	})
+	if mi.Size() == 0 { // first insertion
+		mi.s = v.id
+		mi.graph.addVertex(v)
 
-	if first {
 		return nil
 	}
 
-	mi.graph.addVertex(&vertex)
-
-	_, visited := mi.search(v, 1, listSize, false)
-
-	vertex.neighbors = mi.robustPrune(&vertex, visited, distanceThreshold)
-
-	for _, n := range vertex.neighbors {
-		neighbor := mi.graph.vertices[n]
+	_, visited, err := greedySearch(mi, mi.calculateDistance, v.vector, 0, listSize, 1, nil)
+	if err != nil {
+		return err
+	}
 
-		neighbor.neighbors = append(neighbor.neighbors, vertex.id)
+	mi.graph.addVertex(v)
 
-		if len(neighbor.neighbors) > int(mi.maxDegree) {
-			distances := make([]utils.Element, 0, mi.maxDegree)
+	v.neighbors = mi.robustPrune(v, visited, alpha)
 
-			for _, nn := range neighbor.neighbors {
-				nnVertex := mi.graph.vertices[nn]
-				distances = append(distances, utils.Element{
-					Id:       nn,
-					Distance: mi.calculateDistance(nnVertex.vector, neighbor.vector),
-				})
-			}
-			neighbor.neighbors = mi.robustPrune(neighbor, distances, distanceThreshold)
-		}
+	for _, n := range v.neighbors {
+		mi.addNeighbor(mi.graph.vertices[n], v.id, alpha)
 	}
 
 	return nil
 }
 
-func (mi *MemoryIndex) delete(id uint32) error {
-	// TODO: delete consolidation?
-	return nil
+// addNeighbor connects v to n, pruning v's neighbors if it exceeds the max degree.
+func (mi *MemoryIndex) addNeighbor(v *Vertex, n uint64, alpha float32) {
+	if len(v.neighbors) < int(mi.maxDegree) {
+		v.neighbors = append(v.neighbors, n)
+		return
+	}
+
+	nVertex := mi.graph.vertices[n]
+	candidates := []utils.Element{{Id: n, Distance: mi.calculateDistance(v.vector, nVertex.vector)}}
+	v.neighbors = mi.robustPrune(v, candidates, alpha)
 }
 
-func (mi *MemoryIndex) robustPrune(v *Vertex, candidates []utils.Element, distanceThreshold float32) []uint64 {
-	// TODO locking
-	deletedCandidates := map[uint64]struct{}{v.id: {}} // excluding vertex v
+// robustPrune selects up to maxDegree neighbors for v out of candidates and v's current neighbors.
+// a candidate is skipped if it is alpha times closer to an already selected neighbor than to v.
+func (mi *MemoryIndex) robustPrune(v *Vertex, candidates []utils.Element, alpha float32) []uint64 {
+	excluded := map[uint64]struct{}{v.id: {}} // selected or pruned vertices, starting with v itself
+	skip := func(id uint64) bool {
+		_, ok := excluded[id]
+		_, deleted := mi.deleted[id]
+
+		return ok || deleted
+	}
 
 	for _, n := range v.neighbors {
 		nVertex := mi.graph.vertices[n]
@@ -189,33 +131,34 @@ func (mi *MemoryIndex) robustPrune(v *Vertex, candidates []utils.Element, distan
 		candidates = append(candidates, e)
 	}
 
-	candidatesHeap := utils.NewMinHeapFromSlice(candidates)
+	candidatesHeap := utils.NewMinHeapFromSliceDeep(candidates, len(candidates))
 	newNeighbors := make([]uint64, 0, mi.maxDegree)
 
-	for candidatesHeap.Len() != 0 {
+	for candidatesHeap.Len() != 0 && len(newNeighbors) < int(mi.maxDegree) {
 		min := heap.Pop(candidatesHeap).(utils.Element)
-		if _, ok := deletedCandidates[min.Id]; ok {
+		if skip(min.Id) {
 			continue
 		}
 
 		newNeighbors = append(newNeighbors, min.Id)
+		excluded[min.Id] = struct{}{}
 
-		if len(v.neighbors) == int(mi.maxDegree) {
-			break
-		}
-
+		minVertex := mi.graph.vertices[min.Id]
 		for _, c := range candidatesHeap.Elements {
-			minVertex := mi.graph.vertices[min.Id]
-			cVertex := mi.graph.vertices[c.Id]
+			if skip(c.Id) {
+				continue
+			}
 
-			if distanceThreshold*mi.calculateDistance(minVertex.vector, cVertex.vector) <= c.Distance {
-				deletedCandidates[cVertex.id] = struct{}{}
+			cVertex := mi.graph.vertices[c.Id]
+			if alpha*mi.calculateDistance(minVertex.vector, cVertex.vector) <= c.Distance {
+				excluded[c.Id] = struct{}{} // pruned
 			}
 		}
 	}
 
 	return newNeighbors
 }
+
 func (mi *MemoryIndex) ReadOnly() {
 	mi.readOnly = true
 }
@@ -224,14 +167,9 @@ func (mi *MemoryIndex) Size() uint32 {
 	return uint32(len(mi.graph.vertices))
 }
 
+// snapshot writes the index to path in the dal page format
 func (mi *MemoryIndex) snapshot(path string) error {
-	d, err := newDal(path)
-	if err != nil {
-		return err
-	}
-
-	err = d.writeIndex(mi)
-	if err != nil {
+	if err := writeIndex(path, mi); err != nil {
 		return err
 	}
 
@@ -240,62 +178,24 @@ func (mi *MemoryIndex) snapshot(path string) error {
 	return nil
 }
 
-func (mi *MemoryIndex) serializeMetadata(buff []byte) int {
-	var offset int
-
-	binary.LittleEndian.PutUint32(buff[offset:], mi.dim)
-	offset += 4
-
-	binary.LittleEndian.PutUint32(buff[offset:], mi.maxDegree)
-	offset += 4
-
-	binary.LittleEndian.PutUint64(buff[offset:], mi.firstId)
-	offset += 8
-
-	binary.LittleEndian.PutUint32(buff[offset:], mi.Size())
-	offset += 4
-
-	binary.LittleEndian.PutUint64(buff[offset:], mi.s)
-	offset += 8
-
-	return offset
-}
-
-func (mi *MemoryIndex) deserializeMetadata(buff []byte) int {
-	var offset int
-
-	mi.dim = binary.LittleEndian.Uint32(buff[offset:])
-	offset += 4
-
-	mi.maxDegree = binary.LittleEndian.Uint32(buff[offset:])
-	offset += 4
-
-	mi.firstId = binary.LittleEndian.Uint64(buff[offset:])
-	offset += 8
-
-	mi.size = binary.LittleEndian.Uint32(buff[offset:])
-	offset += 4
-
-	mi.s = binary.LittleEndian.Uint64(buff[offset:])
-	offset += 8
-
-	return offset
-}
-
-func loadMemoryIndex(path string) (*MemoryIndex, error) {
-	d, err := newDal(path)
+func loadMemoryIndex(path string, distFunc func([]float32, []float32) float32,
+	deleted map[uint64]struct{}) (*MemoryIndex, error) {
+	d, err := openDal(path)
 	if err != nil {
 		return nil, err
 	}
 
+	defer d.close()
+
 	mi, err := d.readIndex()
 	if err != nil {
 		return nil, err
 	}
 
-	if err != nil {
-		return nil, err
-	}
+	mi.calculateDistance = distFunc
+	mi.deleted = deleted
+	mi.readOnly = true
+	mi.snapshotPath = path
 
 	return mi, nil
 }
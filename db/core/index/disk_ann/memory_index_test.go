@@ -4,49 +4,63 @@ import (
 	"Vectory/db/core/index/distance"
 	"github.com/pkg/profile"
 	"github.com/stretchr/testify/require"
-	"log"
 	"math/rand"
-	"sync"
+	"os"
 	"testing"
 )
 
 func TestMemoryIndex(t *testing.T) {
 	defer profile.Start(profile.CPUProfile, profile.ProfilePath("./profile")).Stop()
-	mi := newMemoryIndex(distance.Dot, &sync.Map{}, 1, 128, 128)
+	mi := newMemoryIndex(distance.EuclideanDistance, map[uint64]struct{}{}, 32, 64)
 	listSize := 100
-	a := float32(1.3)
+	a := float32(1.2)
+	size := uint64(1000)
 
 	t.Run("insertion", func(t *testing.T) {
-		for i := mi.firstId; i <= 134; i++ {
-			if i == 134 {
-				log.Printf("hi")
-			}
-			err := mi.insert(randomVector(mi.dim), listSize, a, i, i)
+		for i := uint64(1); i <= size; i++ {
+			err := mi.insert(&Vertex{id: i, objId: i, vector: randomVector(mi.dim)}, listSize, a)
+			require.NoError(t, err)
+		}
+
+		for _, v := range mi.graph.vertices {
+			require.LessOrEqual(t, len(v.neighbors), int(mi.maxDegree))
+		}
+	})
+
+	t.Run("search", func(t *testing.T) {
+		var found int
+		for i := uint64(1); i <= size; i++ {
+			res, err := mi.search(mi.graph.vertices[i].vector, 1, listSize, func(*Vertex) bool { return true })
 			require.NoError(t, err)
-			if i%100 == 0 {
-				log.Printf("inserted %d", i)
-			}
 
+			if len(res) > 0 && res[0].Id == i {
+				found++
+			}
 		}
+
+		require.Greater(t, float64(found)/float64(size), 0.95)
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		path := "./ro_0.vctry"
+		defer os.Remove(path)
+
+		err := mi.snapshot(path)
+		require.NoError(t, err)
+
+		restoredIndex, err := loadMemoryIndex(path, mi.calculateDistance, mi.deleted)
+		require.NoError(t, err)
+
+		require.Equal(t, mi.s, restoredIndex.s)
+		require.Equal(t, mi.dim, restoredIndex.dim)
+		require.Equal(t, mi.maxDegree, restoredIndex.maxDegree)
+		require.Equal(t, mi.graph, restoredIndex.graph)
 	})
 
-	//t.Run("snapshot", func(t *testing.T) {
-	//	path := "./ro_0.vctry"
-	//
-	//	err := mi.snapshot(path)
-	//	require.NoError(t, err)
-	//
-	//	d, err := newDal(path)
-	//	require.NoError(t, err)
-	//
-	//	restoredIndex, err := d.readIndex()
-	//	require.NoError(t, err)
-	//
-	//	require.Equal(t, mi.graph, restoredIndex.graph) // TODO: should check for the whole graphs
-	//
-	//	err = os.Remove(path)
-	//	require.NoError(t, err)
-	//})
+	t.Run("wrong dimensions", func(t *testing.T) {
+		err := mi.insert(&Vertex{id: size + 1, vector: randomVector(mi.dim + 1)}, listSize, a)
+		require.ErrorIs(t, err, ErrVectorDimensions)
+	})
 }
 
 func randomVector(dim uint32) []float32 {
@@ -0,0 +1,197 @@
+package disk_ann
+
+import (
+	"Vectory/db/core/index/utils"
+	"fmt"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"os"
+	"sort"
+)
+
+// merge builds a new long-term index out of the current long-term index and the read-only indexes. deleted
+// vertices are removed from the long-term graph and its vertices are reconnected, then the live vertices of the
+// read-only indexes are inserted to it. the long-term graph is loaded into memory while it is merged, and the
+// index is only locked to capture its state at the beginning and to swap the indexes at the end.
+func (da *DiskAnn) merge() error {
+	defer func() {
+		da.Lock()
+		da.merging = false
+		da.Unlock()
+	}()
+
+	da.Lock()
+	roIndexes := append([]*MemoryIndex{}, da.roIndexes...)
+	ltIndex := da.ltIndex
+	dim := da.dim
+	path := fmt.Sprintf("%s/lt_%d.vctry", da.filesPath, da.nextFileId)
+	deleted := make(map[uint64]struct{}, len(da.deleted))
+	for id := range da.deleted {
+		deleted[id] = struct{}{}
+	}
+	da.nextFileId++
+	da.Unlock()
+
+	merged := newMemoryIndex(da.distanceFunction, deleted, da.maxDegree, dim)
+	if ltIndex != nil {
+		var err error
+		if merged, err = ltIndex.load(); err != nil {
+			return errors.Wrap(err, "failed loading long-term index")
+		}
+
+		merged.deleted = deleted
+	}
+
+	merged.consolidateDeletes(da.alpha)
+
+	for _, roIndex := range roIndexes {
+		ids := make([]uint64, 0, roIndex.Size())
+		for id := range roIndex.graph.vertices {
+			if _, ok := deleted[id]; !ok {
+				ids = append(ids, id)
+			}
+		}
+
+		sort.Slice(ids, func(i, j int) bool {
+			return ids[i] < ids[j]
+		})
+
+		for _, id := range ids {
+			v := roIndex.graph.vertices[id]
+			if err := merged.insert(&Vertex{id: v.id, objId: v.objId, vector: v.vector}, da.listSize, da.alpha); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := writeIndex(path, merged); err != nil {
+		return errors.Wrap(err, "failed writing long-term index")
+	}
+
+	newLtIndex, err := openDiskIndex(path, da.distanceFunction)
+	if err != nil {
+		return err
+	}
+
+	da.Lock()
+	defer da.Unlock()
+
+	// vertices deleted before the merge no longer exist in any index
+	for id := range deleted {
+		if ltIndex != nil {
+			if _, ok := ltIndex.position(id); ok {
+				delete(da.deleted, id)
+				continue
+			}
+		}
+
+		for _, roIndex := range roIndexes {
+			if _, ok := roIndex.graph.vertices[id]; ok {
+				delete(da.deleted, id)
+				break
+			}
+		}
+	}
+
+	da.ltIndex = newLtIndex
+	da.roIndexes = da.roIndexes[len(roIndexes):]
+
+	if err = da.writeManifest(); err != nil {
+		return errors.Wrap(err, "failed writing DiskANN manifest")
+	}
+
+	for _, roIndex := range roIndexes {
+		if err = os.Remove(roIndex.snapshotPath); err != nil {
+			logrus.WithError(err).Errorf("failed removing DiskANN read-only index %s", roIndex.snapshotPath)
+		}
+	}
+
+	if ltIndex != nil {
+		if err = ltIndex.close(); err != nil {
+			return err
+		}
+
+		if err = os.Remove(ltIndex.path); err != nil {
+			logrus.WithError(err).Errorf("failed removing DiskANN long-term index %s", ltIndex.path)
+		}
+	}
+
+	return nil
+}
+
+// consolidateDeletes removes the deleted vertices from the graph. every vertex that points to a deleted vertex
+// is reconnected to the deleted vertex's neighbors, and the starting point is replaced if it was deleted.
+func (mi *MemoryIndex) consolidateDeletes(alpha float32) {
+	removed := map[uint64]struct{}{}
+	for id := range mi.graph.vertices {
+		if _, ok := mi.deleted[id]; ok {
+			removed[id] = struct{}{}
+		}
+	}
+
+	if len(removed) == 0 {
+		return
+	}
+
+	for id, v := range mi.graph.vertices {
+		if _, ok := removed[id]; ok {
+			continue
+		}
+
+		var (
+			affected   bool
+			candidates []utils.Element
+		)
+
+		for _, n := range v.neighbors {
+			if _, ok := removed[n]; !ok {
+				continue
+			}
+
+			affected = true
+
+			for _, nn := range mi.graph.vertices[n].neighbors {
+				nnVertex, ok := mi.graph.vertices[nn]
+				if !ok || nn == id {
+					continue
+				}
+
+				candidates = append(candidates, utils.Element{
+					Id:       nn,
+					Distance: mi.calculateDistance(v.vector, nnVertex.vector),
+				})
+			}
+		}
+
+		if affected {
+			v.neighbors = mi.robustPrune(v, candidates, alpha)
+		}
+	}
+
+	s := mi.graph.vertices[mi.s]
+
+	for id := range removed {
+		delete(mi.graph.vertices, id)
+	}
+
+	if _, ok := removed[mi.s]; ok {
+		mi.replaceStartingPoint(s.vector)
+	}
+}
+
+// replaceStartingPoint sets the starting point to the vertex nearest to vector
+func (mi *MemoryIndex) replaceStartingPoint(vector []float32) {
+	var (
+		found   bool
+		minDist float32
+	)
+
+	for id, v := range mi.graph.vertices {
+		dist := mi.calculateDistance(v.vector, vector)
+		if !found || dist < minDist {
+			found = true
+			minDist = dist
+			mi.s = id
+		}
+	}
+}
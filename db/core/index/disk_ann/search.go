@@ -0,0 +1,121 @@
+package disk_ann
+
+import (
+	"Vectory/db/core/index/utils"
+	"container/heap"
+)
+
+// graphReader provides the vertices of a graph to the greedy search, either from memory or from disk.
+type graphReader interface {
+	// entrypoint returns the id of the vertex every search starts from
+	entrypoint() uint64
+
+	// vertex returns the vertex with id
+	vertex(id uint64) (*Vertex, error)
+}
+
+// greedySearch runs a beam search over the graph of r for q, keeping a candidates list of listSize vertices and
+// expanding up to beamWidth of its closest unexpanded vertices in every round. it returns the k nearest vertices
+// that are accepted, keyed by their object id, and all expanded vertices, keyed by their vertex id.
+// vertices that aren't accepted are still used for traversing the graph.
+func greedySearch(r graphReader, distFunc func([]float32, []float32) float32, q []float32, k, listSize, beamWidth int,
+	accept func(*Vertex) bool) ([]utils.Element, []utils.Element, error) {
+	fetched := map[uint64]*Vertex{}
+	fetch := func(id uint64) (*Vertex, error) {
+		if v, ok := fetched[id]; ok {
+			return v, nil
+		}
+
+		v, err := r.vertex(id)
+		if err != nil {
+			return nil, err
+		}
+
+		fetched[id] = v
+
+		return v, nil
+	}
+
+	results := utils.NewMaxHeap(0)
+	addResult := func(v *Vertex, dist float32) {
+		if accept == nil || !accept(v) {
+			return
+		}
+
+		if results.Len() < k {
+			heap.Push(results, utils.Element{Id: v.objId, Distance: dist})
+		} else if dist < results.Peek().(utils.Element).Distance {
+			heap.Pop(results)
+			heap.Push(results, utils.Element{Id: v.objId, Distance: dist})
+		}
+	}
+
+	s, err := fetch(r.entrypoint())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	e := utils.Element{Id: s.id, Distance: distFunc(s.vector, q)}
+	addResult(s, e.Distance)
+
+	visited := map[uint64]struct{}{s.id: {}}
+	candidates := utils.NewMinHeapFromSliceDeep([]utils.Element{e}, listSize+1)
+	nearest := utils.NewMaxHeapFromSliceDeep([]utils.Element{e}, listSize+1)
+
+	var expanded []utils.Element
+	beam := make([]utils.Element, 0, beamWidth)
+
+	for candidates.Len() > 0 {
+		beam = beam[:0]
+		for len(beam) < beamWidth && candidates.Len() > 0 {
+			c := heap.Pop(candidates).(utils.Element)
+			if nearest.Len() >= listSize && c.Distance > nearest.Peek().(utils.Element).Distance {
+				break
+			}
+
+			beam = append(beam, c)
+		}
+
+		if len(beam) == 0 {
+			break
+		}
+
+		for _, c := range beam {
+			expanded = append(expanded, c)
+
+			cVertex, err := fetch(c.Id)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			for _, n := range cVertex.neighbors {
+				if _, ok := visited[n]; ok {
+					continue
+				}
+
+				visited[n] = struct{}{}
+
+				nVertex, err := fetch(n)
+				if err != nil {
+					return nil, nil, err
+				}
+
+				dist := distFunc(nVertex.vector, q)
+				addResult(nVertex, dist)
+
+				if nearest.Len() < listSize || dist < nearest.Peek().(utils.Element).Distance {
+					e = utils.Element{Id: n, Distance: dist}
+
+					heap.Push(candidates, e)
+					heap.Push(nearest, e)
+
+					if nearest.Len() > listSize {
+						heap.Pop(nearest)
+					}
+				}
+			}
+		}
+	}
+
+	return results.Elements, expanded, nil
+}
@@ -0,0 +1,166 @@
+package disk_ann
+
+import (
+	"github.com/pkg/errors"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// load restores the long-term and read-only indexes recorded in the manifest, removes files that aren't in it
+// and rebuilds the write index by replaying the WAL records written after the last read-only snapshot.
+func (da *DiskAnn) load() error {
+	m, err := readManifest(da.filesPath)
+	if err != nil {
+		return errors.Wrap(err, "failed reading DiskANN manifest")
+	}
+
+	if m != nil {
+		if err = da.loadManifest(m); err != nil {
+			return err
+		}
+	}
+
+	if err = da.removeUnusedFiles(m); err != nil {
+		return err
+	}
+
+	da.rwIndex = newMemoryIndex(da.distanceFunction, da.deleted, da.maxDegree, da.dim)
+	da.rebuildVertexIds()
+
+	w, err := newWal(filepath.Join(da.filesPath, "wal"))
+	if err != nil {
+		return err
+	}
+
+	da.wal = w
+
+	return da.loadFromWAL()
+}
+
+func (da *DiskAnn) loadManifest(m *manifest) error {
+	da.dim = m.Dim
+	da.currId = m.NextVertexId
+	da.nextFileId = m.NextFileId
+	da.walSeqNum = m.WalSeqNum
+
+	for _, id := range m.Deleted {
+		da.deleted[id] = struct{}{}
+	}
+
+	if m.LongTerm != "" {
+		ltIndex, err := openDiskIndex(filepath.Join(da.filesPath, m.LongTerm), da.distanceFunction)
+		if err != nil {
+			return errors.Wrapf(err, "failed opening long-term index %s", m.LongTerm)
+		}
+
+		da.ltIndex = ltIndex
+	}
+
+	for _, name := range m.ReadOnly {
+		roIndex, err := loadMemoryIndex(filepath.Join(da.filesPath, name), da.distanceFunction, da.deleted)
+		if err != nil {
+			return errors.Wrapf(err, "failed loading read-only index %s", name)
+		}
+
+		da.roIndexes = append(da.roIndexes, roIndex)
+	}
+
+	return nil
+}
+
+// removeUnusedFiles removes index files that aren't recorded in the manifest
+func (da *DiskAnn) removeUnusedFiles(m *manifest) error {
+	used := map[string]struct{}{}
+	if m != nil {
+		used[m.LongTerm] = struct{}{}
+		for _, name := range m.ReadOnly {
+			used[name] = struct{}{}
+		}
+	}
+
+	for _, pattern := range []string{"*.vctry", "*.tmp"} {
+		files, err := filepath.Glob(filepath.Join(da.filesPath, pattern))
+		if err != nil {
+			return err
+		}
+
+		for _, f := range files {
+			if _, ok := used[filepath.Base(f)]; ok {
+				continue
+			}
+
+			if err = os.Remove(f); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// rebuildVertexIds maps every object to its live vertex in the long-term and read-only indexes
+func (da *DiskAnn) rebuildVertexIds() {
+	add := func(id, objId uint64) {
+		if _, ok := da.deleted[id]; ok {
+			return
+		}
+
+		da.vertexIds[objId] = id
+	}
+
+	if da.ltIndex != nil {
+		for i, id := range da.ltIndex.ids {
+			add(id, da.ltIndex.objIds[i])
+		}
+	}
+
+	for _, roIndex := range da.roIndexes {
+		for id, v := range roIndex.graph.vertices {
+			add(id, v.objId)
+		}
+	}
+}
+
+// loadFromWAL replays the WAL records written after the last read-only snapshot
+func (da *DiskAnn) loadFromWAL() error {
+	first, err := da.wal.f.FirstIndex()
+	if err != nil {
+		return err
+	}
+
+	last, err := da.wal.lastSeqNum()
+	if err != nil {
+		return err
+	}
+
+	if da.walSeqNum > last || first > da.walSeqNum+1 {
+		return errors.Errorf("WAL [%d, %d] doesn't continue the read-only indexes at %d", first, last, da.walSeqNum)
+	}
+
+	r := da.wal.walReader(da.walSeqNum + 1)
+
+	for {
+		record, err := r.Next()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+
+			return err
+		}
+
+		switch record[0] {
+		case insertVertex:
+			err = da.applyInsert(deserializeVertex(record))
+		case deleteObject:
+			da.applyDelete(deserializeObjId(record))
+		default:
+			err = errors.Errorf("unknown WAL opcode %d", record[0])
+		}
+
+		if err != nil {
+			return errors.Wrap(err, "failed building DiskANN write index from WAL")
+		}
+	}
+}
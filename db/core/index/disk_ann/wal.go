@@ -0,0 +1,171 @@
+package disk_ann
+
+import (
+	"encoding/binary"
+	"github.com/pkg/errors"
+	w "github.com/tidwall/wal"
+	"io"
+	"math"
+	"sync"
+)
+
+const (
+	insertVertex byte = iota
+	deleteObject
+)
+
+// wal logs the operations applied to the write index since the last read-only snapshot
+type wal struct {
+	mu     sync.Mutex
+	f      *w.Log
+	batch  *w.Batch
+	seqNum uint64
+}
+
+func newWal(path string) (*wal, error) {
+	f, err := w.Open(path, w.DefaultOptions)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed opening WAL at %s", path)
+	}
+
+	n, err := f.LastIndex()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed retrieving WAL's last sequence number")
+	}
+	n++
+
+	return &wal{f: f,
+		batch:  new(w.Batch),
+		seqNum: n,
+	}, nil
+}
+
+func (w *wal) flush() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if err := w.f.WriteBatch(w.batch); err != nil {
+		return err
+	}
+
+	w.batch.Clear()
+
+	return nil
+}
+
+func (w *wal) insertVertex(v *Vertex) {
+	/*
+		bytes = [opcode, v.id, v.objId, v.vector], len(bytes) = 1 + 8 + 8 + 4*len(v.vector)
+	*/
+
+	bytes := make([]byte, 17+4*len(v.vector))
+
+	bytes[0] = insertVertex
+	binary.LittleEndian.PutUint64(bytes[1:9], v.id)
+	binary.LittleEndian.PutUint64(bytes[9:17], v.objId)
+
+	offset := 17
+	for _, float := range v.vector {
+		binary.LittleEndian.PutUint32(bytes[offset:], math.Float32bits(float))
+		offset += 4
+	}
+
+	w.writeBatch(bytes)
+}
+
+func (w *wal) deleteObject(objId uint64) {
+	/*
+		bytes = [opcode, objId] = 1 + 8
+	*/
+
+	bytes := make([]byte, 9)
+
+	bytes[0] = deleteObject
+	binary.LittleEndian.PutUint64(bytes[1:9], objId)
+
+	w.writeBatch(bytes)
+}
+
+// lastSeqNum returns the sequence number of the last record flushed to the WAL
+func (w *wal) lastSeqNum() (uint64, error) {
+	return w.f.LastIndex()
+}
+
+// truncateUntil removes all records before seqNum from the WAL, the record at seqNum is kept
+// since the WAL can't be left empty
+func (w *wal) truncateUntil(seqNum uint64) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	first, err := w.f.FirstIndex()
+	if err != nil {
+		return err
+	}
+
+	if seqNum <= first {
+		return nil
+	}
+
+	return errors.Wrapf(w.f.TruncateFront(seqNum), "failed truncating WAL until %d", seqNum)
+}
+
+func (w *wal) close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.f.Close()
+}
+
+func (w *wal) writeBatch(data []byte) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.batch.Write(w.seqNum, data)
+	w.seqNum++
+}
+
+type walReader struct {
+	wal *wal
+	pos uint64
+}
+
+// walReader returns a reader over the WAL records starting at seqNum
+func (w *wal) walReader(seqNum uint64) *walReader {
+	return &walReader{
+		wal: w,
+		pos: seqNum,
+	}
+}
+
+func (r *walReader) Next() ([]byte, error) {
+	data, err := r.wal.f.Read(r.pos)
+	if err != nil {
+		if err == w.ErrNotFound {
+			return nil, io.EOF
+		}
+
+		return nil, err
+	}
+
+	r.pos++
+
+	return data, nil
+}
+
+func deserializeVertex(record []byte) *Vertex {
+	v := Vertex{
+		id:     binary.LittleEndian.Uint64(record[1:9]),
+		objId:  binary.LittleEndian.Uint64(record[9:17]),
+		vector: make([]float32, 0, (len(record)-17)/4),
+	}
+
+	for offset := 17; offset < len(record); offset += 4 {
+		v.vector = append(v.vector, math.Float32frombits(binary.LittleEndian.Uint32(record[offset:])))
+	}
+
+	return &v
+}
+
+func deserializeObjId(record []byte) uint64 {
+	return binary.LittleEndian.Uint64(record[1:9])
+}
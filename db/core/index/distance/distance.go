@@ -0,0 +1,20 @@
+package distance
+
+import "Vectory/entities/distance"
+
+// FromType returns the distance function of distanceType and whether vectors must be normalized before
+// being passed to it. cosine distance is calculated as a dot product over normalized vectors.
+func FromType(distanceType string) (func([]float32, []float32) float32, bool) {
+	switch distanceType {
+	case distance.DotProduct:
+		return Dot, false
+	case distance.Cosine:
+		return CosineDistance, true
+	case distance.Manhattan:
+		return ManhattanDistance, false
+	case distance.Hamming:
+		return HammingDistance, false
+	default:
+		return EuclideanDistance, false
+	}
+}
@@ -1,6 +1,7 @@
 package distance
 
 import (
+	"github.com/stretchr/testify/require"
 	"testing"
 )
 
@@ -63,3 +64,50 @@ func randomVector(dim int, n float32) []float32 {
 
 	return vec
 }
+
+func TestDistances(t *testing.T) {
+	v1 := []float32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	v2 := []float32{10, 9, 8, 7, 6, 5, 4, 3, 2, 1}
+
+	t.Run("dot handles dimensions that aren't a multiple of 8", func(t *testing.T) {
+		require.Equal(t, float32(220), Dot(v1, v2))
+	})
+
+	t.Run("manhattan", func(t *testing.T) {
+		require.Equal(t, float32(50), ManhattanDistance(v1, v2))
+	})
+
+	t.Run("cosine", func(t *testing.T) {
+		n1, n2 := Normalize(v1), Normalize(v2)
+
+		require.InDelta(t, 1, magnitude(n1), 1e-6)
+		require.InDelta(t, 0, CosineDistance(n1, n1), 1e-6)
+		require.InDelta(t, 1-220.0/385.0, CosineDistance(n1, n2), 1e-6)
+		require.Equal(t, float32(1), v1[0], "normalize must not modify its input")
+	})
+
+	t.Run("normalize zero vector", func(t *testing.T) {
+		require.Equal(t, []float32{0, 0}, Normalize([]float32{0, 0}))
+	})
+	t.Run("uint8 kernels", func(t *testing.T) {
+		c1 := []byte{0, 1, 2, 255, 128}
+		c2 := []byte{255, 1, 0, 0, 130}
+
+		require.Equal(t, uint32(1*1+128*130), DotUint8(c1, c2))
+		require.Equal(t, uint32(255*255+4+255*255+4), SquaredEuclideanUint8(c1, c2))
+		require.Equal(t, uint32(255+2+255+2), ManhattanUint8(c1, c2))
+	})
+	t.Run("hamming", func(t *testing.T) {
+		b1 := make([]float32, 70)
+		b2 := make([]float32, 70)
+		b1[0], b1[9], b1[64], b1[69] = 1, 1, 1, 1
+		b2[9], b2[65] = 1, 1
+
+		p1, p2 := PackBits(b1), PackBits(b2)
+		require.Len(t, p1, 9)
+		require.Equal(t, b1, UnpackBits(p1, 70))
+
+		require.Equal(t, float32(4), HammingDistance(b1, b2))
+		require.Equal(t, uint32(4), HammingBits(p1, p2))
+	})
+}
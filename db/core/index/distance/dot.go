@@ -5,9 +5,12 @@ import (
 )
 
 var dotProductImplementation = func(v1 []float32, v2 []float32) float32 {
-	var sum float32
+	var (
+		sum float32
+		i   int
+	)
 
-	for i := 0; i < len(v1); i += 8 {
+	for ; i+8 <= len(v1); i += 8 {
 		sum += v1[i] * v2[i]
 		sum += v1[i+1] * v2[i+1]
 		sum += v1[i+2] * v2[i+2]
@@ -18,9 +21,9 @@ var dotProductImplementation = func(v1 []float32, v2 []float32) float32 {
 		sum += v1[i+7] * v2[i+7]
 	}
 
-	//for i := 0; i < len(v1); i++ {
-	//	sum += v1[i] * v2[i]
-	//}
+	for ; i < len(v1); i++ { // remainder of vectors whose dimension isn't a multiple of 8
+		sum += v1[i] * v2[i]
+	}
 
 	return sum
 }
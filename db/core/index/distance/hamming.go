@@ -0,0 +1,63 @@
+package distance
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// HammingBits counts the differing bits of two packed binary vectors
+func HammingBits(v1, v2 []byte) uint32 {
+	var (
+		sum int
+		i   int
+	)
+
+	for ; i+8 <= len(v1); i += 8 {
+		sum += bits.OnesCount64(binary.LittleEndian.Uint64(v1[i:]) ^ binary.LittleEndian.Uint64(v2[i:]))
+	}
+
+	for ; i < len(v1); i++ { // remainder of vectors that aren't a multiple of 64 bits
+		sum += bits.OnesCount8(v1[i] ^ v2[i])
+	}
+
+	return uint32(sum)
+}
+
+// HammingDistance counts the differing dimensions of two unpacked binary vectors
+func HammingDistance(v1, v2 []float32) float32 {
+	var sum float32
+
+	for i := 0; i < len(v1); i++ {
+		if v1[i] != v2[i] {
+			sum++
+		}
+	}
+
+	return sum
+}
+
+// PackBits packs a binary vector to bits, every non-zero value is set
+func PackBits(v []float32) []byte {
+	packed := make([]byte, (len(v)+7)/8)
+
+	for i, x := range v {
+		if x != 0 {
+			packed[i/8] |= 1 << (i % 8)
+		}
+	}
+
+	return packed
+}
+
+// UnpackBits returns the binary vector of dim dimensions packed to packed
+func UnpackBits(packed []byte, dim int) []float32 {
+	v := make([]float32, dim)
+
+	for i := range v {
+		if packed[i/8]&(1<<(i%8)) != 0 {
+			v[i] = 1
+		}
+	}
+
+	return v
+}
@@ -12,7 +12,7 @@ func EuclideanDistance(v1, v2 []float32) float32 {
 	return float32(math.Sqrt(float64(sum)))
 }
 
-func manhatthanDistance(v1, v2 []float32) float32 {
+func ManhattanDistance(v1, v2 []float32) float32 {
 	var sum float64
 
 	for i := 0; i < len(v1); i++ {
@@ -22,11 +22,26 @@ func manhatthanDistance(v1, v2 []float32) float32 {
 	return float32(sum)
 }
 
-func cosineSimilarity(v1, v2 []float32) float32 {
-	dot := Dot(v1, v2)
-	m1, m2 := magnitude(v1), magnitude(v2)
+// CosineDistance expects both vectors to be normalized, so the cosine similarity is their dot product
+func CosineDistance(v1, v2 []float32) float32 {
+	return 1 - Dot(v1, v2)
+}
+
+// Normalize returns a copy of v scaled to unit length, a zero vector is returned as is
+func Normalize(v []float32) []float32 {
+	normalized := make([]float32, len(v))
+
+	m := magnitude(v)
+	if m == 0 {
+		copy(normalized, v)
+		return normalized
+	}
+
+	for i := range v {
+		normalized[i] = v[i] / m
+	}
 
-	return dot / (m1 * m2)
+	return normalized
 }
 
 func magnitude(v []float32) float32 {
@@ -0,0 +1,38 @@
+package distance
+
+// integer kernels over quantized vectors with a single byte per dimension
+
+func DotUint8(v1, v2 []byte) uint32 {
+	var sum uint32
+
+	for i := 0; i < len(v1); i++ {
+		sum += uint32(v1[i]) * uint32(v2[i])
+	}
+
+	return sum
+}
+
+func SquaredEuclideanUint8(v1, v2 []byte) uint32 {
+	var sum uint32
+
+	for i := 0; i < len(v1); i++ {
+		d := int32(v1[i]) - int32(v2[i])
+		sum += uint32(d * d)
+	}
+
+	return sum
+}
+
+func ManhattanUint8(v1, v2 []byte) uint32 {
+	var sum uint32
+
+	for i := 0; i < len(v1); i++ {
+		if v1[i] > v2[i] {
+			sum += uint32(v1[i] - v2[i])
+		} else {
+			sum += uint32(v2[i] - v1[i])
+		}
+	}
+
+	return sum
+}
@@ -0,0 +1,275 @@
+package filtering
+
+import (
+	"Vectory/db/core/index/utils"
+	"Vectory/entities/filters"
+	"encoding/json"
+	"sort"
+	"sync"
+)
+
+// property holds the postings of the values of a property
+type property struct {
+	postings map[interface{}]map[uint64]struct{} // postings[key] are the documents whose value has key
+	numbers  []float64                           // sorted keys of the numeric values, for range filters
+	strings  []string                            // sorted keys of the string values, for range filters
+	docs     *utils.Bitmap                       // documents that have the property, whatever its value
+}
+
+// Index is an in memory index of the properties of documents, it returns the documents matching a filter
+// without reading them.
+type Index struct {
+	sync.RWMutex
+	properties map[string]*property
+	docs       map[uint64]map[string]interface{} // keys of the scalar values of each document, nil for other values
+	all        *utils.Bitmap
+}
+
+func NewIndex() *Index {
+	return &Index{
+		properties: make(map[string]*property),
+		docs:       make(map[uint64]map[string]interface{}),
+		all:        utils.NewBitmap(),
+	}
+}
+
+// Add indexes properties as document id, replacing it if it's already indexed
+func (idx *Index) Add(id uint64, properties map[string]interface{}) {
+	keys := make(map[string]interface{}, len(properties))
+	for name, v := range properties {
+		keys[name] = key(v)
+	}
+
+	idx.Lock()
+	defer idx.Unlock()
+
+	idx.delete(id)
+
+	for name, k := range keys {
+		p, ok := idx.properties[name]
+		if !ok {
+			p = &property{postings: make(map[interface{}]map[uint64]struct{}), docs: utils.NewBitmap()}
+			idx.properties[name] = p
+		}
+
+		p.docs.Add(id)
+
+		if k != nil {
+			p.add(k, id)
+		}
+	}
+
+	idx.docs[id] = keys
+	idx.all.Add(id)
+}
+
+// Delete removes document id from the index
+func (idx *Index) Delete(id uint64) {
+	idx.Lock()
+	defer idx.Unlock()
+
+	idx.delete(id)
+}
+
+func (idx *Index) delete(id uint64) {
+	keys, ok := idx.docs[id]
+	if !ok {
+		return
+	}
+
+	for name, k := range keys {
+		p := idx.properties[name]
+		p.docs.Remove(id)
+
+		if k != nil {
+			p.remove(k, id)
+		}
+	}
+
+	delete(idx.docs, id)
+	idx.all.Remove(id)
+}
+
+// Len returns the number of indexed documents
+func (idx *Index) Len() int {
+	idx.RLock()
+	defer idx.RUnlock()
+
+	return len(idx.docs)
+}
+
+// Match returns the documents whose properties match f the same way as f.Match. f is assumed to be valid.
+func (idx *Index) Match(f *filters.Filter) *utils.Bitmap {
+	idx.RLock()
+	defer idx.RUnlock()
+
+	return idx.match(f)
+}
+
+func (idx *Index) match(f *filters.Filter) *utils.Bitmap {
+	switch f.Operator {
+	case filters.And:
+		res := idx.match(f.Operands[0])
+		for _, o := range f.Operands[1:] {
+			res.And(idx.match(o))
+		}
+
+		return res
+	case filters.Or:
+		res := utils.NewBitmap()
+		for _, o := range f.Operands {
+			res.Or(idx.match(o))
+		}
+
+		return res
+	case filters.Not:
+		res := idx.all.Clone()
+		res.AndNot(idx.match(f.Operands[0]))
+
+		return res
+	}
+
+	res := utils.NewBitmap()
+
+	p, ok := idx.properties[f.Property]
+	if !ok {
+		return res
+	}
+
+	switch f.Operator {
+	case filters.Equal:
+		p.union(res, f.Value)
+	case filters.NotEqual:
+		res = p.docs.Clone()
+		equal := utils.NewBitmap()
+		p.union(equal, f.Value)
+		res.AndNot(equal)
+	case filters.In:
+		for _, v := range f.Value.([]interface{}) {
+			p.union(res, v)
+		}
+	default:
+		p.unionRange(res, f.Operator, f.Value)
+	}
+
+	return res
+}
+
+// union adds the documents whose value equals v to res
+func (p *property) union(res *utils.Bitmap, v interface{}) {
+	if k, ok := filters.Key(v); ok {
+		p.unionKey(res, k)
+	}
+}
+
+// unionRange adds the documents whose value compares with v as operator requires to res. numbers are compared
+// only with numbers and strings only with strings.
+func (p *property) unionRange(res *utils.Bitmap, operator string, v interface{}) {
+	k, _ := filters.Key(v)
+
+	switch k := k.(type) {
+	case float64:
+		from, to := bounds(len(p.numbers), operator, func(i int) bool { return p.numbers[i] >= k }, func(i int) bool { return p.numbers[i] > k })
+		for _, n := range p.numbers[from:to] {
+			p.unionKey(res, n)
+		}
+	case string:
+		from, to := bounds(len(p.strings), operator, func(i int) bool { return p.strings[i] >= k }, func(i int) bool { return p.strings[i] > k })
+		for _, s := range p.strings[from:to] {
+			p.unionKey(res, s)
+		}
+	}
+}
+
+// unionKey adds the documents whose value has key k to res
+func (p *property) unionKey(res *utils.Bitmap, k interface{}) {
+	for id := range p.postings[k] {
+		res.Add(id)
+	}
+}
+
+// bounds returns the range of the n sorted keys that match operator, given the predicates telling whether the
+// i-th key is greater than or equal to and greater than the filter's value.
+func bounds(n int, operator string, gte, gt func(int) bool) (int, int) {
+	switch operator {
+	case filters.LessThan:
+		return 0, sort.Search(n, gte)
+	case filters.LessThanOrEqual:
+		return 0, sort.Search(n, gt)
+	case filters.GreaterThan:
+		return sort.Search(n, gt), n
+	case filters.GreaterThanOrEqual:
+		return sort.Search(n, gte), n
+	}
+
+	return 0, 0
+}
+
+func (p *property) add(k interface{}, id uint64) {
+	postings, ok := p.postings[k]
+	if !ok {
+		postings = make(map[uint64]struct{})
+		p.postings[k] = postings
+
+		switch k := k.(type) {
+		case float64:
+			i := sort.SearchFloat64s(p.numbers, k)
+			p.numbers = append(p.numbers, 0)
+			copy(p.numbers[i+1:], p.numbers[i:])
+			p.numbers[i] = k
+		case string:
+			i := sort.SearchStrings(p.strings, k)
+			p.strings = append(p.strings, "")
+			copy(p.strings[i+1:], p.strings[i:])
+			p.strings[i] = k
+		}
+	}
+
+	postings[id] = struct{}{}
+}
+
+func (p *property) remove(k interface{}, id uint64) {
+	delete(p.postings[k], id)
+
+	if len(p.postings[k]) > 0 {
+		return
+	}
+
+	delete(p.postings, k)
+
+	switch k := k.(type) {
+	case float64:
+		i := sort.SearchFloat64s(p.numbers, k)
+		p.numbers = append(p.numbers[:i], p.numbers[i+1:]...)
+	case string:
+		i := sort.SearchStrings(p.strings, k)
+		p.strings = append(p.strings[:i], p.strings[i+1:]...)
+	}
+}
+
+// key returns the key v is indexed with, or nil if it's not a scalar. values are keyed as they're read back from
+// the object store, where they're decoded from JSON, e.g. dates are strings.
+func key(v interface{}) interface{} {
+	if k, ok := filters.Key(v); ok {
+		return k
+	}
+
+	switch v.(type) {
+	case nil, []interface{}, map[string]interface{}:
+		return nil
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+
+	var decoded interface{}
+	if err = json.Unmarshal(b, &decoded); err != nil {
+		return nil
+	}
+
+	k, _ := filters.Key(decoded)
+
+	return k
+}
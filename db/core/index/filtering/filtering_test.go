@@ -0,0 +1,96 @@
+package filtering
+
+import (
+	"Vectory/entities/filters"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestIndex(t *testing.T) {
+	idx := NewIndex()
+
+	countries := []string{"France", "Italy", "Spain"}
+	documents := make(map[uint64]map[string]interface{})
+
+	for id := uint64(0); id < 500; id++ {
+		properties := map[string]interface{}{
+			"country": countries[rand.Intn(len(countries))],
+			"price":   rand.Intn(50),
+			"organic": rand.Intn(2) == 0,
+		}
+
+		switch id % 10 {
+		case 0:
+			delete(properties, "price")
+		case 1:
+			properties["price"] = fmt.Sprintf("%d", rand.Intn(50)) // strings are compared only with strings
+		case 2:
+			properties["tags"] = []interface{}{"red", "dry"}
+		}
+
+		documents[id] = properties
+		idx.Add(id, properties)
+	}
+
+	// replaced and deleted documents
+	for id := uint64(0); id < 500; id += 7 {
+		documents[id] = map[string]interface{}{"country": "Italy", "price": float64(25)}
+		idx.Add(id, documents[id])
+	}
+
+	for id := uint64(3); id < 500; id += 11 {
+		delete(documents, id)
+		idx.Delete(id)
+	}
+
+	require.Equal(t, len(documents), idx.Len())
+
+	italy := &filters.Filter{Operator: filters.Equal, Property: "country", Value: "Italy"}
+	cheap := &filters.Filter{Operator: filters.LessThan, Property: "price", Value: 25}
+
+	cases := []*filters.Filter{
+		italy,
+		{Operator: filters.NotEqual, Property: "country", Value: "Italy"},
+		{Operator: filters.Equal, Property: "price", Value: float32(25)},
+		{Operator: filters.Equal, Property: "organic", Value: true},
+		{Operator: filters.In, Property: "country", Value: []interface{}{"France", "Spain", 10}},
+		cheap,
+		{Operator: filters.LessThanOrEqual, Property: "price", Value: 25},
+		{Operator: filters.GreaterThan, Property: "price", Value: 25.5},
+		{Operator: filters.GreaterThanOrEqual, Property: "price", Value: "3"},
+		{Operator: filters.GreaterThan, Property: "country", Value: "France"},
+		{Operator: filters.NotEqual, Property: "tags", Value: "red"},
+		{Operator: filters.Equal, Property: "missing", Value: "Italy"},
+		{Operator: filters.And, Operands: []*filters.Filter{italy, cheap}},
+		{Operator: filters.Or, Operands: []*filters.Filter{italy, cheap}},
+		{Operator: filters.Not, Operands: []*filters.Filter{cheap}},
+		{Operator: filters.Not, Operands: []*filters.Filter{{Operator: filters.And, Operands: []*filters.Filter{italy, cheap}}}},
+	}
+
+	for _, f := range cases {
+		var expected []uint64
+		for id := uint64(0); id < 500; id++ {
+			if properties, ok := documents[id]; ok && f.Match(properties) {
+				expected = append(expected, id)
+			}
+		}
+
+		matches := idx.Match(f)
+		require.Equal(t, len(expected), matches.Len(), "%+v", f)
+
+		if len(expected) > 0 {
+			require.Equal(t, expected, matches.ToSlice(), "%+v", f)
+		}
+	}
+
+	t.Run("values are keyed as decoded from JSON", func(t *testing.T) {
+		date := time.Date(2023, 4, 1, 10, 0, 0, 0, time.UTC)
+		idx.Add(1000, map[string]interface{}{"date": date})
+
+		matches := idx.Match(&filters.Filter{Operator: filters.GreaterThanOrEqual, Property: "date", Value: "2023-01-01"})
+		require.Equal(t, []uint64{1000}, matches.ToSlice())
+	})
+}
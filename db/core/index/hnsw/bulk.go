@@ -0,0 +1,42 @@
+package hnsw
+
+// BeginBulk stops writing the WAL so that the graph is built faster, e.g. while a new collection is loaded.
+// the modifications made until EndBulk are persisted only by its snapshot, they're lost if the index is closed or
+// the process stops before it.
+func (h *Hnsw) BeginBulk() error {
+	h.maintenanceLock.Lock()
+	defer h.maintenanceLock.Unlock()
+
+	if err := h.wal.flush(); err != nil {
+		return err
+	}
+
+	h.wal.disabled.Store(true)
+
+	return nil
+}
+
+// EndBulk snapshots the graph built since BeginBulk and resumes writing the WAL.
+func (h *Hnsw) EndBulk() error {
+	h.snapshotMu.Lock()
+	defer h.snapshotMu.Unlock()
+
+	if h.closed {
+		return nil
+	}
+
+	h.maintenanceLock.Lock()
+
+	// nothing was written to the WAL since BeginBulk, so the snapshot is at its last record
+	seqNum, err := h.wal.lastSeqNum()
+	if err != nil {
+		h.maintenanceLock.Unlock()
+		return err
+	}
+
+	b := h.serializeSnapshot(seqNum)
+	h.wal.disabled.Store(false)
+	h.maintenanceLock.Unlock()
+
+	return h.writeSnapshot(seqNum, b)
+}
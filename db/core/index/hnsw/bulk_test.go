@@ -0,0 +1,91 @@
+package hnsw
+
+import (
+	"Vectory/db/core/objstore"
+	"Vectory/entities/index"
+	objstoreentities "Vectory/entities/objstore"
+	"github.com/stretchr/testify/require"
+	"math/rand"
+	"os"
+	"sync"
+	"testing"
+)
+
+func TestHnsw_Bulk(t *testing.T) {
+	filesPath := "./tmp_bulk"
+	defer os.RemoveAll(filesPath)
+
+	store, err := objstore.NewStores(filesPath)
+	require.NoError(t, err)
+	defer store.Close()
+
+	h, err := NewHnsw(index.DefaultHnswParams, filesPath, store)
+	require.NoError(t, err)
+
+	size, dim, workers := 1000, 32, 4
+	vectors := make([][]float32, size)
+	for i := range vectors {
+		vectors[i] = make([]float32, dim)
+		for j := range vectors[i] {
+			vectors[i][j] = rand.Float32()
+		}
+
+		require.NoError(t, store.PutObject(&objstoreentities.Object{Id: uint64(i), Vector: vectors[i]}))
+	}
+
+	// crash stops the index without snapshotting it
+	crash := func(h *Hnsw) {
+		close(h.stopMaintenance)
+		require.NoError(t, h.wal.flush())
+		require.NoError(t, h.wal.close())
+	}
+
+	require.NoError(t, h.BeginBulk())
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+
+			for i := w; i < size; i += workers {
+				require.NoError(t, h.Insert(vectors[i], uint64(i)))
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	last, err := h.wal.lastSeqNum()
+	require.NoError(t, err)
+	require.Zero(t, last)
+
+	require.NoError(t, h.EndBulk())
+
+	// logged after the snapshot
+	require.NoError(t, h.Delete(0))
+	crash(h)
+
+	restored, err := NewHnsw(index.DefaultHnswParams, filesPath, store)
+	require.NoError(t, err)
+
+	require.Equal(t, h.entrypointID, restored.entrypointID)
+	require.Len(t, restored.nodes, size)
+	require.Contains(t, restored.deletedNodes, uint64(0))
+
+	for id, v := range h.nodes {
+		require.Equal(t, v.connections, restored.nodes[id].connections)
+	}
+
+	t.Run("interrupted bulk is lost", func(t *testing.T) {
+		require.NoError(t, restored.BeginBulk())
+		require.NoError(t, restored.Delete(1))
+		crash(restored)
+
+		restored, err = NewHnsw(index.DefaultHnswParams, filesPath, store)
+		require.NoError(t, err)
+		defer restored.Close()
+
+		require.NotContains(t, restored.deletedNodes, uint64(1))
+		require.Contains(t, restored.deletedNodes, uint64(0))
+	})
+}
@@ -1,7 +1,18 @@
 package hnsw
 
-// TODO: delete currently only soft deletes the desired vertex. a mechanism for hard deletes ned to be implemented
+import (
+	"Vectory/db/core/index/utils"
+	"github.com/sirupsen/logrus"
+	"sync"
+	"time"
+)
+
+// Delete marks the vertex with id as deleted. the vertex is still traversed by searches
+// until it is removed from the graph by the tombstones cleanup.
 func (h *Hnsw) Delete(id uint64) error {
+	h.maintenanceLock.RLock()
+	defer h.maintenanceLock.RUnlock()
+
 	h.Lock()
 	defer h.Unlock()
 
@@ -10,3 +21,203 @@ func (h *Hnsw) Delete(id uint64) error {
 
 	return nil
 }
+
+// Ids returns the ids of the vertices in the graph that are not deleted.
+func (h *Hnsw) Ids() []uint64 {
+	h.maintenanceLock.RLock() // tombstones are removed from the maps under the maintenance lock only
+	defer h.maintenanceLock.RUnlock()
+
+	h.RLock()
+	defer h.RUnlock()
+
+	ids := make([]uint64, 0, len(h.nodes))
+	for id := range h.nodes {
+		if _, ok := h.deletedNodes[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// cleanupTombstonesPeriodically runs the tombstones cleanup every cleanupInterval until the index is closed.
+func (h *Hnsw) cleanupTombstonesPeriodically() {
+	ticker := time.NewTicker(h.cleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-h.stopMaintenance:
+			return
+		case <-ticker.C:
+			if err := h.cleanupTombstones(); err != nil {
+				logrus.WithError(err).Error("failed cleaning up hnsw tombstones")
+			}
+		}
+	}
+}
+
+// cleanupTombstones removes deleted vertices from the graph in batches of tombstonesCleanupBatchSize.
+func (h *Hnsw) cleanupTombstones() error {
+	for {
+		removed, err := h.cleanupTombstonesBatch(tombstonesCleanupBatchSize)
+		if err != nil {
+			return err
+		}
+
+		if removed < tombstonesCleanupBatchSize {
+			return nil
+		}
+	}
+}
+
+// cleanupTombstonesBatch hard deletes up to size tombstones. every vertex that points to a removed vertex is
+// reconnected to the removed vertex's neighbors, and the entrypoint is reassigned if it was removed.
+// all other operations on the index are blocked while the batch is processed.
+func (h *Hnsw) cleanupTombstonesBatch(size int) (int, error) {
+	h.maintenanceLock.Lock()
+	defer h.maintenanceLock.Unlock()
+
+	batch := newSet[uint64]()
+	for id := range h.deletedNodes {
+		if len(batch) == size {
+			break
+		}
+
+		if _, ok := h.nodes[id]; !ok { // id was never inserted to the graph
+			delete(h.deletedNodes, id)
+			continue
+		}
+
+		batch.Add(id)
+	}
+
+	if len(batch) == 0 {
+		return 0, nil
+	}
+
+	for id, v := range h.nodes {
+		if batch.Contains(id) {
+			continue
+		}
+
+		for l := range v.connections {
+			h.repairConnections(v, int64(l), batch)
+		}
+	}
+
+	if batch.Contains(h.entrypointID) {
+		h.reassignEntrypoint(batch)
+	}
+
+	for id := range batch {
+		h.wal.removeVertex(id)
+		delete(h.nodes, id)
+		delete(h.deletedNodes, id)
+	}
+
+	if h.isEmpty() {
+		h.initialInsertion = &sync.Once{}
+	}
+
+	return len(batch), h.wal.flush()
+}
+
+// repairConnections replaces the connections of v at level that point to removed vertices with
+// the best candidates among v's remaining neighbors and the neighbors of the removed vertices.
+func (h *Hnsw) repairConnections(v *Vertex, level int64, removed Set[uint64]) {
+	connections := v.GetConnections(level)
+
+	var affected bool
+	for _, n := range connections {
+		if removed.Contains(n) {
+			affected = true
+			break
+		}
+	}
+
+	if !affected {
+		return
+	}
+
+	visited := newSet[uint64]()
+	visited.Add(v.id)
+
+	candidates := make([]utils.Element, 0, len(connections))
+	addCandidate := func(id uint64) {
+		if visited.Contains(id) || removed.Contains(id) {
+			return
+		}
+
+		visited.Add(id)
+		candidates = append(candidates, utils.Element{
+			Id:       id,
+			Distance: h.vertexDistance(v, h.nodes[id]),
+		})
+	}
+
+	for _, n := range connections {
+		if !removed.Contains(n) {
+			addCandidate(n)
+			continue
+		}
+
+		nVertex := h.nodes[n]
+		if int(level) >= len(nVertex.connections) {
+			continue
+		}
+
+		for _, nn := range nVertex.GetConnections(level) {
+			addCandidate(nn)
+		}
+	}
+
+	maxConn := h.mMax
+	if level == 0 {
+		maxConn = h.mMax0
+	}
+
+	neighbors := h.selectNeighbors(v, candidates, maxConn)
+
+	h.wal.setConnectionsAtLevel(v.id, int(level), neighbors)
+	v.SetConnections(level, neighbors)
+}
+
+// reassignEntrypoint sets the entrypoint to the vertex with the highest layer that is not removed,
+// preferring vertices that are not marked as deleted.
+func (h *Hnsw) reassignEntrypoint(removed Set[uint64]) {
+	var (
+		found     bool
+		candidate uint64
+		maxLayer  int64 = -1
+	)
+
+	for id, v := range h.nodes {
+		if removed.Contains(id) {
+			continue
+		}
+
+		layer := int64(len(v.connections)) - 1
+		_, deleted := h.deletedNodes[id]
+		if deleted {
+			layer -= h.currentMaxLayer + 1 // rank deleted vertices below every live vertex
+		}
+
+		if !found || layer > maxLayer {
+			found = true
+			candidate = id
+			maxLayer = layer
+		}
+	}
+
+	if !found {
+		h.entrypointID = 0
+		h.currentMaxLayer = 0
+
+		return
+	}
+
+	h.entrypointID = candidate
+	h.currentMaxLayer = int64(len(h.nodes[candidate].connections)) - 1
+	h.wal.setEntryPointWithMaxLayer(h.entrypointID, int(h.currentMaxLayer))
+}
@@ -1 +1,130 @@
 package hnsw
+
+import (
+	"Vectory/db/core/objstore"
+	"Vectory/entities/index"
+	objstoreentities "Vectory/entities/objstore"
+	"Vectory/entities/search"
+	"github.com/stretchr/testify/require"
+	"math/rand"
+	"os"
+	"testing"
+)
+
+func TestHnsw_CleanupTombstones(t *testing.T) {
+	filesPath := "./tmp_delete"
+	defer os.RemoveAll(filesPath)
+
+	store, err := objstore.NewStores(filesPath)
+	require.NoError(t, err)
+
+	h, err := NewHnsw(index.DefaultHnswParams, filesPath, store)
+	require.NoError(t, err)
+
+	size, dim := 1000, 32
+	vectors := make([][]float32, size)
+	for i := 0; i < size; i++ {
+		vectors[i] = make([]float32, dim)
+		for j := range vectors[i] {
+			vectors[i][j] = rand.Float32()
+		}
+
+		require.NoError(t, store.PutObject(&objstoreentities.Object{Id: uint64(i), Vector: vectors[i]}))
+		require.NoError(t, h.Insert(vectors[i], uint64(i)))
+	}
+
+	deleted := newSet[uint64]()
+	deleted.Add(h.entrypointID)
+	for len(deleted) < size/5 {
+		deleted.Add(uint64(rand.Intn(size)))
+	}
+
+	for id := range deleted {
+		require.NoError(t, h.Delete(id))
+	}
+
+	require.NoError(t, h.cleanupTombstones())
+
+	t.Run("deleted vertices are removed from the graph", func(t *testing.T) {
+		require.Len(t, h.nodes, size-len(deleted))
+		require.Empty(t, h.deletedNodes)
+		require.False(t, deleted.Contains(h.entrypointID))
+
+		for _, v := range h.nodes {
+			for _, connections := range v.connections {
+				for _, n := range connections {
+					require.False(t, deleted.Contains(n))
+				}
+			}
+		}
+	})
+
+	t.Run("remaining vertices are reachable", func(t *testing.T) {
+		var found int
+		for i := 0; i < size; i++ {
+			if deleted.Contains(uint64(i)) {
+				continue
+			}
+
+			res := h.Search(vectors[i], search.NewOptions(1))
+			if len(res) > 0 && res[0].Id == uint64(i) {
+				found++
+			}
+		}
+
+		require.Greater(t, float64(found)/float64(size-len(deleted)), 0.9)
+	})
+
+	t.Run("restore from WAL", func(t *testing.T) {
+		require.NoError(t, h.Flush())
+
+		hRestored, err := NewHnsw(index.DefaultHnswParams, filesPath, store)
+		require.NoError(t, err)
+
+		require.Equal(t, h.entrypointID, hRestored.entrypointID)
+		require.Equal(t, h.currentMaxLayer, hRestored.currentMaxLayer)
+		require.Equal(t, h.nodes, hRestored.nodes)
+		require.Equal(t, h.deletedNodes, hRestored.deletedNodes)
+	})
+
+	t.Run("ids while tombstones are removed", func(t *testing.T) {
+		for id := 0; id < size/5; id++ {
+			if _, ok := h.nodes[uint64(id)]; ok {
+				require.NoError(t, h.Delete(uint64(id)))
+			}
+		}
+
+		stop, done := make(chan struct{}), make(chan struct{})
+		go func() {
+			defer close(done)
+
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+					h.Ids()
+				}
+			}
+		}()
+
+		require.NoError(t, h.cleanupTombstones())
+		close(stop)
+		<-done
+
+		require.Len(t, h.Ids(), len(h.nodes))
+	})
+
+	t.Run("delete all vertices and insert again", func(t *testing.T) {
+		for id := range h.nodes {
+			require.NoError(t, h.Delete(id))
+		}
+
+		require.NoError(t, h.cleanupTombstones())
+		require.Empty(t, h.nodes)
+		require.Empty(t, h.Search(vectors[0], search.NewOptions(1)))
+
+		require.NoError(t, h.Insert(vectors[0], 0))
+		require.Equal(t, uint64(0), h.Search(vectors[0], search.NewOptions(1))[0].Id)
+	})
+}
@@ -5,103 +5,99 @@ import (
 	"fmt"
 )
 
-type deserializer struct {
-	state *Hnsw
+// walRecord is a decoded WAL record, the fields that aren't part of its op are zero
+type walRecord struct {
+	op        byte
+	id        uint64
+	level     int64
+	neighbors []uint64
 }
 
-func (d *deserializer) restore(record []byte) error {
-	op := d.getOp(record)
-
-	switch op {
-	case AddVertex:
-		d.addVertex(record[1:])
-	case SetEntryPointWithMaxLayer:
-		d.setEntryPointWithMaxLayer(record[1:])
-	case SetConnectionsAtLevel:
-		d.setConnectionsAtLevel(record[1:])
-	case addConnectionAtLevel:
-		d.addConnectionAtLevel(record[1:])
-	case deleteVertex:
-		d.deleteVertex(record[1:])
-	default:
-		return fmt.Errorf("unkown opcode %d", op)
-	}
-
-	return nil
+// recordSizes are the sizes of the records of each op, set connections records are followed by their neighbors
+var recordSizes = map[byte]int{
+	AddVertex:                 13,
+	SetEntryPointWithMaxLayer: 13,
+	SetConnectionsAtLevel:     17,
+	addConnectionAtLevel:      21,
+	deleteVertex:              9,
+	removeVertex:              9,
 }
 
-func (d *deserializer) getOp(record []byte) byte {
-	return record[0]
-}
+// decodeRecord decodes a record written by the WAL.
+func decodeRecord(record []byte) (walRecord, error) {
+	if len(record) == 0 {
+		return walRecord{}, fmt.Errorf("empty record")
+	}
 
-func (d *deserializer) addVertex(record []byte) {
-	var offset int
-	v := Vertex{}
+	r := walRecord{op: record[0]}
 
-	v.id = binary.LittleEndian.Uint64(record[offset:])
-	offset += 8
+	size, ok := recordSizes[r.op]
+	if !ok {
+		return walRecord{}, fmt.Errorf("unkown opcode %d", r.op)
+	}
 
-	level := int64(binary.LittleEndian.Uint32(record[offset:]))
-	offset += 4
+	if len(record) < size {
+		return walRecord{}, fmt.Errorf("record of opcode %d is %d bytes instead of %d", r.op, len(record), size)
+	}
 
-	v.Init(level+1, d.state.mMax, d.state.mMax0)
-	d.state.nodes[v.id] = &v
-}
+	r.id = binary.LittleEndian.Uint64(record[1:])
 
-func (d *deserializer) setEntryPointWithMaxLayer(record []byte) {
-	var offset int
+	switch r.op {
+	case AddVertex, SetEntryPointWithMaxLayer:
+		r.level = int64(binary.LittleEndian.Uint32(record[9:]))
+	case SetConnectionsAtLevel:
+		r.level = int64(binary.LittleEndian.Uint32(record[9:]))
 
-	d.state.entrypointID = binary.LittleEndian.Uint64(record[offset:])
-	offset += 8
+		n := int(binary.LittleEndian.Uint32(record[13:]))
+		if len(record) != size+8*n {
+			return walRecord{}, fmt.Errorf("record of %d neighbors is %d bytes", n, len(record))
+		}
 
-	d.state.currentMaxLayer = int64(binary.LittleEndian.Uint32(record[offset:]))
-	offset += 4
+		r.neighbors = make([]uint64, n)
+		for i := range r.neighbors {
+			r.neighbors[i] = binary.LittleEndian.Uint64(record[size+8*i:])
+		}
+	case addConnectionAtLevel:
+		r.level = int64(binary.LittleEndian.Uint32(record[9:]))
+		r.neighbors = []uint64{binary.LittleEndian.Uint64(record[13:])}
+	}
 
+	return r, nil
 }
-func (d *deserializer) addConnectionAtLevel(record []byte) {
-	var offset int
-
-	id := binary.LittleEndian.Uint64(record[offset:])
-	offset += 8
 
-	level := binary.LittleEndian.Uint32(record[offset:])
-	offset += 4
-
-	nid := binary.LittleEndian.Uint64(record[offset:])
-	offset += 8
-
-	v := d.state.nodes[id]
-
-	v.AddConnection(int64(level), nid)
+type deserializer struct {
+	state *Hnsw
 }
-func (d *deserializer) setConnectionsAtLevel(record []byte) {
-	var offset int
 
-	id := binary.LittleEndian.Uint64(record[offset:])
-	offset += 8
-
-	level := binary.LittleEndian.Uint32(record[offset:])
-	offset += 4
-
-	size := binary.LittleEndian.Uint32(record[offset:])
-	offset += 4
-
-	v := d.state.nodes[id]
-	neighbors := make([]uint64, int(size))
-
-	for i := 0; i < int(size); i++ {
-		neighbors[i] = binary.LittleEndian.Uint64(record[offset:])
-		offset += 8
+func (d *deserializer) restore(record []byte) error {
+	r, err := decodeRecord(record)
+	if err != nil {
+		return err
 	}
 
-	v.SetConnections(int64(level), neighbors)
-}
-
-func (d *deserializer) deleteVertex(record []byte) {
-	var offset int
+	d.apply(r)
 
-	id := binary.LittleEndian.Uint64(record[offset:])
-	offset += 8
+	return nil
+}
 
-	d.state.deletedNodes[id] = struct{}{}
+// apply applies r to the state, the vertices r modifies must exist
+func (d *deserializer) apply(r walRecord) {
+	switch r.op {
+	case AddVertex:
+		v := Vertex{id: r.id}
+		v.Init(r.level+1, d.state.mMax, d.state.mMax0)
+		d.state.nodes[v.id] = &v
+	case SetEntryPointWithMaxLayer:
+		d.state.entrypointID = r.id
+		d.state.currentMaxLayer = r.level
+	case SetConnectionsAtLevel:
+		d.state.nodes[r.id].SetConnections(r.level, r.neighbors)
+	case addConnectionAtLevel:
+		d.state.nodes[r.id].AddConnection(r.level, r.neighbors[0])
+	case deleteVertex:
+		d.state.deletedNodes[r.id] = struct{}{}
+	case removeVertex:
+		delete(d.state.nodes, r.id)
+		delete(d.state.deletedNodes, r.id)
+	}
 }
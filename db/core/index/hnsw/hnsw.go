@@ -3,6 +3,7 @@ package hnsw
 import (
 	"Vectory/db/core/index"
 	"Vectory/db/core/index/distance"
+	"Vectory/db/core/index/quantization"
 	"Vectory/db/core/index/utils"
 	"Vectory/db/core/objstore"
 	distanceentities "Vectory/entities/distance"
@@ -10,9 +11,19 @@ import (
 	"fmt"
 	"math"
 	"sync"
+	"time"
 )
 
-var _ index.VectorIndex = &Hnsw{}
+const (
+	tombstonesCleanupInterval  = time.Minute
+	tombstonesCleanupBatchSize = 10000
+	snapshotInterval           = 10 * time.Minute
+)
+
+var (
+	_ index.VectorIndex = &Hnsw{}
+	_ index.BulkBuilder = &Hnsw{}
+)
 
 type Hnsw struct {
 	sync.RWMutex
@@ -27,10 +38,23 @@ type Hnsw struct {
 	nodes            map[uint64]*Vertex
 	deletedNodes     map[uint64]struct{}
 	distFunc         func([]float32, []float32) float32
+	normalize        bool // vectors are normalized before being indexed or searched
 	selectNeighbors  func(*Vertex, []utils.Element, int) []uint64
 	initialInsertion *sync.Once
 	filesPath        string
 	wal              *wal
+	maintenanceLock  sync.RWMutex // held exclusively while tombstones are removed from the graph or it is snapshotted
+	cleanupInterval  time.Duration
+	stopMaintenance  chan struct{}
+	snapshotPath     string
+	snapshotInterval time.Duration
+	snapshotSeqNum   uint64     // WAL sequence number contained in the last snapshot
+	snapshotMu       sync.Mutex // serializes snapshots and Close
+	closed           bool
+	compression      *compression           // nil when vectors aren't compressed
+	quantizer        quantization.Quantizer // trained once the index reaches the compression training size
+	quantizerPath    string
+	store            *objstore.Stores // holds the full vectors compressed vertices are rescored with
 }
 
 func NewHnsw(params indexentities.HnswParams, filesPath string, store *objstore.Stores) (*Hnsw, error) {
@@ -43,16 +67,22 @@ func NewHnsw(params indexentities.HnswParams, filesPath string, store *objstore.
 		deletedNodes:     map[uint64]struct{}{},
 		initialInsertion: &sync.Once{},
 		filesPath:        fmt.Sprintf("%s/%s", filesPath, "index"),
+		cleanupInterval:  tombstonesCleanupInterval,
+		stopMaintenance:  make(chan struct{}),
+		snapshotPath:     fmt.Sprintf("%s/%s", filesPath, "index.snapshot"),
+		snapshotInterval: snapshotInterval,
+		compression:      newCompression(params),
+		quantizerPath:    fmt.Sprintf("%s/%s", filesPath, "index.quantizer"),
+		store:            store,
 	}
 
 	h.mMax0 = 2 * h.mMax
 	h.mL = 1 / math.Log(float64(h.m))
 
-	switch params.DistanceType {
-	case distanceentities.DotProduct:
-		h.distFunc = distance.Dot
-	case distanceentities.Euclidean:
-		h.distFunc = distance.EuclideanDistance
+	h.distFunc, h.normalize = distance.FromType(params.DistanceType)
+
+	if params.DistanceType == distanceentities.Hamming { // binary vectors are always kept packed
+		h.quantizer = quantization.NewBinaryQuantizer()
 	}
 
 	h.selectNeighbors = h.selectNeighborsSimple
@@ -68,7 +98,11 @@ func NewHnsw(params indexentities.HnswParams, filesPath string, store *objstore.
 
 	h.wal = w
 
-	if err = h.loadFromWAL(); err != nil {
+	if err = h.load(); err != nil {
+		return nil, err
+	}
+
+	if err = h.loadQuantizer(); err != nil {
 		return nil, err
 	}
 
@@ -76,9 +110,65 @@ func NewHnsw(params indexentities.HnswParams, filesPath string, store *objstore.
 		return nil, err
 	}
 
+	h.compressIfNeeded()
+
+	go h.cleanupTombstonesPeriodically()
+	go h.snapshotPeriodically()
+
 	return &h, nil
 }
 
 func (h *Hnsw) Flush() error {
 	return h.wal.flush()
 }
+
+// Pause flushes the WAL and blocks snapshots, tombstones cleanup and compression until Resume is called.
+func (h *Hnsw) Pause() error {
+	h.snapshotMu.Lock()
+	h.maintenanceLock.RLock()
+
+	if err := h.wal.flush(); err != nil {
+		h.Resume()
+		return err
+	}
+
+	return nil
+}
+
+// Resume unblocks the maintenance of the index blocked by Pause.
+func (h *Hnsw) Resume() {
+	h.maintenanceLock.RUnlock()
+	h.snapshotMu.Unlock()
+}
+
+// Compact removes all tombstones from the graph and snapshots it, which truncates the WAL.
+func (h *Hnsw) Compact() error {
+	if err := h.cleanupTombstones(); err != nil {
+		return err
+	}
+
+	return h.snapshot()
+}
+
+// Close stops the background maintenance, snapshots the graph and closes the WAL.
+func (h *Hnsw) Close() error {
+	close(h.stopMaintenance)
+
+	if err := h.snapshot(); err != nil {
+		return err
+	}
+
+	h.snapshotMu.Lock()
+	defer h.snapshotMu.Unlock()
+
+	h.closed = true
+
+	h.maintenanceLock.Lock()
+	defer h.maintenanceLock.Unlock()
+
+	if err := h.wal.flush(); err != nil {
+		return err
+	}
+
+	return h.wal.close()
+}
@@ -1,11 +1,15 @@
 package hnsw
 
 import (
+	"Vectory/db/core/index/utils"
 	"Vectory/db/core/objstore"
+	"Vectory/entities/distance"
 	"Vectory/entities/index"
 	objstoreentities "Vectory/entities/objstore"
+	"Vectory/entities/search"
 	"fmt"
 	"github.com/stretchr/testify/require"
+	"math/rand"
 	"os"
 	"testing"
 	"time"
@@ -57,3 +61,124 @@ func TestRestoreFromDisk(t *testing.T) {
 	require.Equal(t, h.nodes, hRestored.nodes)
 	require.Equal(t, h.deletedNodes, hRestored.deletedNodes)
 }
+
+func TestHnsw_DistanceTypes(t *testing.T) {
+	size, dim := 500, 20
+
+	vectors := make([][]float32, size)
+	for i := range vectors {
+		vectors[i] = make([]float32, dim)
+		for j := range vectors[i] {
+			vectors[i][j] = rand.Float32()*2 - 1
+		}
+	}
+
+	for _, distanceType := range []string{distance.Cosine, distance.Manhattan} {
+		t.Run(distanceType, func(t *testing.T) {
+			filesPath := "./tmp_" + distanceType
+			defer os.RemoveAll(filesPath)
+
+			params := index.DefaultHnswParams
+			params.DistanceType = distanceType
+
+			h, err := NewHnsw(params, filesPath, nil)
+			require.NoError(t, err)
+			defer h.Close()
+
+			for i, v := range vectors {
+				require.NoError(t, h.Insert(v, uint64(i)))
+			}
+
+			var found int
+			for i, v := range vectors {
+				res := h.Search(v, search.NewOptions(1))
+				require.Len(t, res, 1)
+				require.InDelta(t, 0, res[0].Distance, 1e-5)
+
+				if res[0].Id == uint64(i) {
+					found++
+				}
+			}
+
+			require.Greater(t, float64(found)/float64(size), 0.95)
+		})
+	}
+
+	t.Run("cosine is scale invariant", func(t *testing.T) {
+		filesPath := "./tmp_cosine_scale"
+		defer os.RemoveAll(filesPath)
+
+		params := index.DefaultHnswParams
+		params.DistanceType = distance.Cosine
+
+		h, err := NewHnsw(params, filesPath, nil)
+		require.NoError(t, err)
+		defer h.Close()
+
+		for i, v := range vectors {
+			require.NoError(t, h.Insert(v, uint64(i)))
+		}
+
+		q := make([]float32, dim)
+		for j := range q {
+			q[j] = vectors[7][j] * 42
+		}
+
+		res := h.Search(q, search.NewOptions(1))
+		require.Len(t, res, 1)
+		require.Equal(t, uint64(7), res[0].Id)
+	})
+}
+
+func TestHnsw_SearchOptions(t *testing.T) {
+	filesPath := "./tmp_search_options"
+	defer os.RemoveAll(filesPath)
+
+	size, dim := 2000, 16
+
+	h, err := NewHnsw(index.DefaultHnswParams, filesPath, nil)
+	require.NoError(t, err)
+	defer h.Close()
+
+	vectors := make([][]float32, size)
+	for i := range vectors {
+		vectors[i] = make([]float32, dim)
+		for j := range vectors[i] {
+			vectors[i][j] = rand.Float32()
+		}
+
+		require.NoError(t, h.Insert(vectors[i], uint64(i)))
+	}
+
+	q := vectors[0]
+
+	t.Run("ef", func(t *testing.T) {
+		opts := search.NewOptions(50)
+		opts.Ef = 10
+
+		require.Len(t, h.Search(q, opts), 50)
+
+		opts.Ef = 500
+		res := h.Search(q, opts)
+		require.Len(t, res, 50)
+		require.Equal(t, uint64(0), res[0].Id)
+	})
+
+	t.Run("max distance", func(t *testing.T) {
+		all := h.Search(q, search.NewOptions(100))
+
+		opts := search.NewOptions(100)
+		opts.MaxDistance = &all[9].Distance
+
+		res := h.Search(q, opts)
+		require.Equal(t, all[:10], res)
+
+		allowList := utils.NewBitmap()
+		for _, e := range all[5:] {
+			allowList.Add(e.Id)
+		}
+
+		res = h.SearchWithAllowList(q, opts, allowList)
+		require.Equal(t, all[5:10], res)
+	})
+}
@@ -5,17 +5,35 @@ import (
 	"fmt"
 )
 
+// Insert adds vector to the graph, the index is compressed once the insertion brings it to the
+// product quantization training size.
 func (h *Hnsw) Insert(vector []float32, vectorId uint64) error {
+	if err := h.insert(vector, vectorId); err != nil {
+		return err
+	}
+
+	h.compressIfNeeded()
+
+	return nil
+}
+
+func (h *Hnsw) insert(vector []float32, vectorId uint64) error {
+	h.maintenanceLock.RLock()
+	defer h.maintenanceLock.RUnlock()
+
 	var (
 		first bool
 		err   error
 	)
 
+	vector = h.prepareVector(vector)
+
 	v := Vertex{
-		id:     vectorId,
-		vector: vector,
+		id: vectorId,
 	}
 
+	h.setVector(&v, vector)
+
 	h.initialInsertion.Do(func() {
 		if h.isEmpty() {
 			err = h.insertFirstVertex(&v)
@@ -47,11 +65,48 @@ func (h *Hnsw) Insert(vector []float32, vectorId uint64) error {
 
 	h.RLock()
 	entrypointID := h.entrypointID
-	epVertex := h.nodes[entrypointID]
 	currentMaxLayer := h.currentMaxLayer
 	h.RUnlock()
 
-	dist := h.calculateDistance(epVertex.vector, v.vector)
+	h.link(&v, vector, vertexLayer, entrypointID, currentMaxLayer)
+
+	h.Lock()
+	if vertexLayer > currentMaxLayer {
+		h.wal.setEntryPointWithMaxLayer(v.id, int(vertexLayer))
+		h.entrypointID = v.id
+		h.currentMaxLayer = vertexLayer
+	}
+	h.Unlock()
+
+	return nil
+}
+
+func (h *Hnsw) insertFirstVertex(v *Vertex) error {
+	h.Lock()
+	defer h.Unlock()
+
+	v.Init(1, -1, h.mMax0)
+
+	h.wal.setEntryPointWithMaxLayer(v.id, 0)
+
+	h.entrypointID = v.id
+	h.currentMaxLayer = 0
+
+	h.wal.addVertex(v)
+	h.nodes[v.id] = v
+
+	return nil
+}
+
+// link connects v to its nearest neighbors in every layer from vertexLayer down to 0,
+// starting the lookup from entrypointID. vector is the full vector of v.
+func (h *Hnsw) link(v *Vertex, vector []float32, vertexLayer int64, entrypointID uint64, currentMaxLayer int64) {
+	h.RLock()
+	epVertex := h.nodes[entrypointID]
+	h.RUnlock()
+
+	d := h.newDistancer(vector)
+	dist := d.distance(epVertex)
 
 	var nearestNeighbors []utils.Element
 
@@ -60,19 +115,21 @@ func (h *Hnsw) Insert(vector []float32, vectorId uint64) error {
 
 	// Lookup Phase
 	for l := currentMaxLayer; l > vertexLayer; l-- {
-		nearestNeighbors = h.searchLayer(&v, eps, 1, l)
+		nearestNeighbors = h.searchLayer(d, eps, 1, l)
 		eps[0] = nearestNeighbors[0]
 	}
 
 	// Construction Phase
 	maxConn := h.mMax
 	for l := min(currentMaxLayer, vertexLayer); l >= 0; l-- {
-		nearestNeighbors = h.searchLayer(&v, eps, h.efConstruction, l)
-		neighbors := h.selectNeighbors(&v, nearestNeighbors, h.m)
+		nearestNeighbors = h.searchLayer(d, eps, h.efConstruction, l)
+		neighbors := h.selectNeighbors(v, excludeVertex(nearestNeighbors, v.id), h.m)
 
 		h.wal.setConnectionsAtLevel(v.id, int(l), neighbors)
 
+		v.Lock()
 		v.SetConnections(l, neighbors)
+		v.Unlock()
 
 		if l == 0 {
 			maxConn = h.mMax0
@@ -86,6 +143,11 @@ func (h *Hnsw) Insert(vector []float32, vectorId uint64) error {
 			nVertex.Lock()
 			connections := nVertex.GetConnections(l)
 
+			if contains(connections, v.id) { // already connected when re-linking an updated vertex
+				nVertex.Unlock()
+				continue
+			}
+
 			if len(connections) < maxConn {
 				h.wal.addConnectionAtLevel(n, int(l), v.id)
 				nVertex.AddConnection(l, v.id)
@@ -94,7 +156,7 @@ func (h *Hnsw) Insert(vector []float32, vectorId uint64) error {
 
 				elems = append(elems, utils.Element{
 					Id:       v.id,
-					Distance: h.calculateDistance(nVertex.vector, v.vector),
+					Distance: h.vertexDistance(nVertex, v),
 				})
 
 				for _, nn := range connections {
@@ -102,7 +164,7 @@ func (h *Hnsw) Insert(vector []float32, vectorId uint64) error {
 					nnVertex := h.nodes[nn]
 					h.RUnlock()
 
-					elems = append(elems, utils.Element{Id: nn, Distance: h.calculateDistance(nVertex.vector, nnVertex.vector)})
+					elems = append(elems, utils.Element{Id: nn, Distance: h.vertexDistance(nVertex, nnVertex)})
 				}
 
 				newNeighbors := h.selectNeighbors(nVertex, elems, maxConn)
@@ -114,31 +176,4 @@ func (h *Hnsw) Insert(vector []float32, vectorId uint64) error {
 
 		eps = nearestNeighbors
 	}
-
-	h.Lock()
-	if vertexLayer > currentMaxLayer {
-		h.wal.setEntryPointWithMaxLayer(v.id, int(vertexLayer))
-		h.entrypointID = v.id
-		h.currentMaxLayer = vertexLayer
-	}
-	h.Unlock()
-
-	return nil
-}
-
-func (h *Hnsw) insertFirstVertex(v *Vertex) error {
-	h.Lock()
-	defer h.Unlock()
-
-	v.Init(1, -1, h.mMax0)
-
-	h.wal.setEntryPointWithMaxLayer(v.id, 0)
-
-	h.entrypointID = v.id
-	h.currentMaxLayer = 0
-
-	h.wal.addVertex(v)
-	h.nodes[v.id] = v
-
-	return nil
 }
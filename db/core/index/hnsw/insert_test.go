@@ -1,6 +1,7 @@
 package hnsw
 
 import (
+	"Vectory/entities/search"
 	"fmt"
 	"github.com/pkg/profile"
 	"log"
@@ -71,7 +72,7 @@ func TestSift(t *testing.T) {
 	var avgRecall float32
 	for i, q := range queryVectors {
 		var match float32
-		ann := hnsw.Search(q, k)
+		ann := hnsw.Search(q, search.NewOptions(k))
 		truth := truthNeighbors[i]
 
 		for _, n := range ann {
@@ -0,0 +1,238 @@
+package hnsw
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+	"io"
+	"os"
+	"sort"
+)
+
+// opNames are the names of the WAL records' ops in WALRecord
+var opNames = map[byte]string{
+	AddVertex:                 "add_vertex",
+	SetEntryPointWithMaxLayer: "set_entrypoint",
+	SetConnectionsAtLevel:     "set_connections",
+	addConnectionAtLevel:      "add_connection",
+	deleteVertex:              "delete_vertex",
+	removeVertex:              "remove_vertex",
+}
+
+// WALRecord is a record of the WAL of an index, the fields that aren't part of its op are zero.
+type WALRecord struct {
+	SeqNum    uint64   `json:"seq_num"`
+	Op        string   `json:"op"`
+	Id        uint64   `json:"id"`
+	Level     int64    `json:"level"`
+	Neighbors []uint64 `json:"neighbors,omitempty"`
+}
+
+// Stats of the graph of an index.
+type Stats struct {
+	Vertices        int         `json:"vertices"`   // vertices in the graph, including the deleted ones
+	Tombstones      int         `json:"tombstones"` // deleted vertices that weren't removed from the graph yet
+	EntrypointID    uint64      `json:"entrypoint_id"`
+	MaxLayer        int64       `json:"max_layer"`
+	LayerCounts     []int       `json:"layer_counts"`     // number of vertices in each layer
+	DegreeHistogram map[int]int `json:"degree_histogram"` // number of vertices by their number of connections in layer 0
+	SnapshotSeqNum  uint64      `json:"snapshot_seq_num"` // last WAL record contained in the snapshot, 0 without one
+	WALRecords      int         `json:"wal_records"`      // records replayed after the snapshot
+	Problems        []string    `json:"problems,omitempty"`
+}
+
+// ReadWAL calls fn with the records of the WAL of the index in filesPath, from the oldest one. the index must be
+// closed, and fn's error stops the reading and is returned.
+func ReadWAL(filesPath string, fn func(WALRecord) error) error {
+	h, err := openForInspection(filesPath)
+	if err != nil {
+		return err
+	}
+	defer h.wal.close()
+
+	first, err := h.wal.f.FirstIndex()
+	if err != nil {
+		return err
+	}
+
+	r := h.wal.walReader(first)
+
+	for seqNum := first; ; seqNum++ {
+		record, err := r.Next()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+
+			return err
+		}
+
+		rec, err := decodeRecord(record)
+		if err != nil {
+			return errors.Wrapf(err, "failed decoding WAL record %d", seqNum)
+		}
+
+		if err = fn(WALRecord{SeqNum: seqNum, Op: opNames[rec.op], Id: rec.id, Level: rec.level, Neighbors: rec.neighbors}); err != nil {
+			return err
+		}
+	}
+}
+
+// Inspect restores the graph of the index in filesPath from its snapshot and WAL and returns its stats. the index
+// must be closed. unlike opening the index, inconsistencies of the snapshot, WAL and graph are reported in the
+// stats' problems instead of failing, and the index's files aren't modified.
+func Inspect(filesPath string) (*Stats, error) {
+	h, err := openForInspection(filesPath)
+	if err != nil {
+		return nil, err
+	}
+	defer h.wal.close()
+
+	stats := Stats{LayerCounts: []int{}, DegreeHistogram: map[int]int{}}
+
+	if stats.SnapshotSeqNum, err = h.loadSnapshot(); err != nil {
+		return nil, errors.Wrapf(err, "failed loading snapshot from %s", h.snapshotPath)
+	}
+
+	if stats.WALRecords, err = h.replayForInspection(stats.SnapshotSeqNum, &stats.Problems); err != nil {
+		return nil, err
+	}
+
+	h.checkGraph(&stats.Problems)
+
+	stats.Vertices = len(h.nodes)
+	stats.EntrypointID = h.entrypointID
+	stats.MaxLayer = h.currentMaxLayer
+
+	for id, v := range h.nodes {
+		if _, ok := h.deletedNodes[id]; ok {
+			stats.Tombstones++
+		}
+
+		for len(stats.LayerCounts) < len(v.connections) {
+			stats.LayerCounts = append(stats.LayerCounts, 0)
+		}
+
+		for l := range v.connections {
+			stats.LayerCounts[l]++
+		}
+
+		if len(v.connections) > 0 {
+			stats.DegreeHistogram[len(v.connections[0])]++
+		}
+	}
+
+	sort.Strings(stats.Problems)
+
+	return &stats, nil
+}
+
+// openForInspection opens the WAL of the index in filesPath without restoring the graph.
+func openForInspection(filesPath string) (*Hnsw, error) {
+	h := Hnsw{
+		nodes:        make(map[uint64]*Vertex),
+		deletedNodes: map[uint64]struct{}{},
+		filesPath:    fmt.Sprintf("%s/%s", filesPath, "index"),
+		snapshotPath: fmt.Sprintf("%s/%s", filesPath, "index.snapshot"),
+	}
+
+	// the WAL would be created if it's missing
+	if _, err := os.Stat(h.filesPath); err != nil {
+		return nil, errors.Wrapf(err, "no hnsw index in %s", filesPath)
+	}
+
+	w, err := newWal(h.filesPath)
+	if err != nil {
+		return nil, err
+	}
+
+	h.wal = w
+
+	return &h, nil
+}
+
+// replayForInspection applies the WAL records written after the snapshot at seqNum and returns their number.
+// records that can't be decoded or applied are skipped and added to problems.
+func (h *Hnsw) replayForInspection(seqNum uint64, problems *[]string) (int, error) {
+	first, err := h.wal.f.FirstIndex()
+	if err != nil {
+		return 0, err
+	}
+
+	last, err := h.wal.lastSeqNum()
+	if err != nil {
+		return 0, err
+	}
+
+	if seqNum > last || first > seqNum+1 {
+		*problems = append(*problems, fmt.Sprintf("WAL [%d, %d] doesn't continue snapshot at %d", first, last, seqNum))
+		return 0, nil
+	}
+
+	r := h.wal.walReader(seqNum + 1)
+	d := deserializer{state: h}
+
+	var n int
+	for pos := seqNum + 1; ; pos++ {
+		record, err := r.Next()
+		if err != nil {
+			if err == io.EOF {
+				return n, nil
+			}
+
+			return 0, err
+		}
+
+		n++
+
+		rec, err := decodeRecord(record)
+		if err == nil {
+			err = h.checkRecord(rec)
+		}
+
+		if err != nil {
+			*problems = append(*problems, fmt.Sprintf("WAL record %d: %s", pos, err))
+			continue
+		}
+
+		d.apply(rec)
+	}
+}
+
+// checkRecord reports whether rec can be applied to the graph.
+func (h *Hnsw) checkRecord(rec walRecord) error {
+	if rec.op != SetConnectionsAtLevel && rec.op != addConnectionAtLevel {
+		return nil
+	}
+
+	v, ok := h.nodes[rec.id]
+	if !ok {
+		return fmt.Errorf("%s of missing vertex %d", opNames[rec.op], rec.id)
+	}
+
+	if rec.level >= int64(len(v.connections)) {
+		return fmt.Errorf("%s of vertex %d at level %d above its top level %d", opNames[rec.op], rec.id, rec.level, len(v.connections)-1)
+	}
+
+	return nil
+}
+
+// checkGraph adds the inconsistencies of the graph to problems.
+func (h *Hnsw) checkGraph(problems *[]string) {
+	if _, ok := h.nodes[h.entrypointID]; !ok && len(h.nodes) > 0 {
+		*problems = append(*problems, fmt.Sprintf("entrypoint %d is missing", h.entrypointID))
+	}
+
+	for id, v := range h.nodes {
+		if top := int64(len(v.connections)) - 1; top > h.currentMaxLayer {
+			*problems = append(*problems, fmt.Sprintf("vertex %d top level %d is above max layer %d", id, top, h.currentMaxLayer))
+		}
+
+		for l, connections := range v.connections {
+			for _, n := range connections {
+				if _, ok := h.nodes[n]; !ok {
+					*problems = append(*problems, fmt.Sprintf("vertex %d is connected to missing vertex %d at level %d", id, n, l))
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package hnsw
+
+import (
+	"Vectory/db/core/objstore"
+	"Vectory/entities/index"
+	objstoreentities "Vectory/entities/objstore"
+	"github.com/stretchr/testify/require"
+	"math/rand"
+	"os"
+	"testing"
+)
+
+func TestHnsw_Inspect(t *testing.T) {
+	filesPath := "./tmp_inspect"
+	defer os.RemoveAll(filesPath)
+
+	store, err := objstore.NewStores(filesPath)
+	require.NoError(t, err)
+	defer store.Close()
+
+	h, err := NewHnsw(index.DefaultHnswParams, filesPath, store)
+	require.NoError(t, err)
+
+	size, dim := 500, 32
+	insert := func(from, to int) {
+		for i := from; i < to; i++ {
+			vector := make([]float32, dim)
+			for j := range vector {
+				vector[j] = rand.Float32()
+			}
+
+			require.NoError(t, store.PutObject(&objstoreentities.Object{Id: uint64(i), Vector: vector}))
+			require.NoError(t, h.Insert(vector, uint64(i)))
+		}
+	}
+
+	insert(0, size/2)
+	require.NoError(t, h.snapshot())
+
+	insert(size/2, size)
+	for i := 0; i < size/10; i++ {
+		require.NoError(t, h.Delete(uint64(i)))
+	}
+
+	// the index isn't closed, so the records after the snapshot are only in the WAL
+	close(h.stopMaintenance)
+	require.NoError(t, h.wal.flush())
+	require.NoError(t, h.wal.close())
+
+	t.Run("stats", func(t *testing.T) {
+		stats, err := Inspect(filesPath)
+		require.NoError(t, err)
+		require.Empty(t, stats.Problems)
+
+		require.Equal(t, size, stats.Vertices)
+		require.Equal(t, size/10, stats.Tombstones)
+		require.Equal(t, h.entrypointID, stats.EntrypointID)
+		require.Equal(t, h.currentMaxLayer, stats.MaxLayer)
+		require.Equal(t, h.snapshotSeqNum, stats.SnapshotSeqNum)
+		require.Len(t, stats.LayerCounts, int(h.currentMaxLayer)+1)
+		require.Equal(t, size, stats.LayerCounts[0])
+
+		var histogramSize int
+		for _, n := range stats.DegreeHistogram {
+			histogramSize += n
+		}
+
+		require.Equal(t, size, histogramSize)
+
+		var records int
+		err = ReadWAL(filesPath, func(r WALRecord) error {
+			if r.SeqNum > stats.SnapshotSeqNum {
+				records++
+			}
+
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, stats.WALRecords, records)
+	})
+
+	t.Run("problems", func(t *testing.T) {
+		w, err := newWal(h.filesPath)
+		require.NoError(t, err)
+
+		w.addConnectionAtLevel(uint64(size)+1, 0, 1)
+		w.setConnectionsAtLevel(uint64(size)-1, 100, []uint64{1})
+		w.setConnectionsAtLevel(uint64(size)-1, 0, []uint64{uint64(size) + 2})
+		require.NoError(t, w.flush())
+		require.NoError(t, w.close())
+
+		stats, err := Inspect(filesPath)
+		require.NoError(t, err)
+		require.Len(t, stats.Problems, 3)
+		require.Contains(t, stats.Problems[0], "add_connection of missing vertex")
+		require.Contains(t, stats.Problems[1], "set_connections of vertex")
+		require.Contains(t, stats.Problems[2], "is connected to missing vertex")
+
+		_, err = Inspect("./missing")
+		require.Error(t, err)
+	})
+}
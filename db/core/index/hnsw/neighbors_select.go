@@ -59,7 +59,7 @@ func (h *Hnsw) selectNeighborsHeuristic(v *Vertex, candidates []utils.Element, m
 			rVertex := h.nodes[r]
 			h.RUnlock()
 
-			if h.distFunc(eVertex.vector, rVertex.vector) < e.Distance {
+			if h.vertexDistance(eVertex, rVertex) < e.Distance {
 				flag = false
 				break
 			}
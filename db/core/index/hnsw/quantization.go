@@ -0,0 +1,240 @@
+package hnsw
+
+import (
+	"Vectory/db/core/index/quantization"
+	"Vectory/db/core/index/utils"
+	indexentities "Vectory/entities/index"
+	"fmt"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"os"
+	"sort"
+)
+
+// compression configures the quantizer vectors are compressed with once the index reaches trainingSize
+type compression struct {
+	trainingSize int
+	rescore      bool // final candidates are rescored with the full vectors from the store
+	newQuantizer func(dim int) (quantization.Quantizer, error)
+	unmarshal    func(b []byte) (quantization.Quantizer, error)
+}
+
+// newCompression returns the compression configured in params, or nil when vectors aren't compressed
+func newCompression(params indexentities.HnswParams) *compression {
+	switch {
+	case params.PQ != nil:
+		return &compression{
+			trainingSize: params.PQ.TrainingSize,
+			rescore:      true,
+			newQuantizer: func(dim int) (quantization.Quantizer, error) {
+				return quantization.NewProductQuantizer(dim, params.PQ.Subspaces, params.PQ.Centroids, params.DistanceType)
+			},
+			unmarshal: func(b []byte) (quantization.Quantizer, error) {
+				return quantization.UnmarshalProductQuantizer(b, params.DistanceType)
+			},
+		}
+	case params.SQ != nil:
+		return &compression{
+			trainingSize: params.SQ.TrainingSize,
+			rescore:      params.SQ.Rescore,
+			newQuantizer: func(dim int) (quantization.Quantizer, error) {
+				return quantization.NewScalarQuantizer(dim, params.DistanceType), nil
+			},
+			unmarshal: func(b []byte) (quantization.Quantizer, error) {
+				return quantization.UnmarshalScalarQuantizer(b, params.DistanceType)
+			},
+		}
+	default:
+		return nil
+	}
+}
+
+// distancer calculates the distance of a query from vertices, compressed vertices are compared
+// using the quantizer's query distancer.
+type distancer struct {
+	h  *Hnsw
+	q  []float32
+	qd quantization.QueryDistancer
+}
+
+func (h *Hnsw) newDistancer(q []float32) *distancer {
+	d := distancer{h: h, q: q}
+
+	if h.quantizer != nil {
+		d.qd = h.quantizer.NewQueryDistancer(q)
+	}
+
+	return &d
+}
+
+func (d *distancer) distance(v *Vertex) float32 {
+	if v.code != nil {
+		return d.qd.Distance(v.code)
+	}
+
+	return d.h.calculateDistance(v.vector, d.q)
+}
+
+// vertexDistance returns the distance between two vertices, either of them may be compressed
+func (h *Hnsw) vertexDistance(v1, v2 *Vertex) float32 {
+	switch {
+	case v1.code != nil && v2.code != nil:
+		return h.quantizer.CodeDistance(v1.code, v2.code)
+	case v1.code != nil:
+		return h.quantizer.VectorDistance(v2.vector, v1.code)
+	case v2.code != nil:
+		return h.quantizer.VectorDistance(v1.vector, v2.code)
+	default:
+		return h.calculateDistance(v1.vector, v2.vector)
+	}
+}
+
+// setVector sets the vector of v, it is kept only as a code once the index is compressed
+func (h *Hnsw) setVector(v *Vertex, vector []float32) {
+	if h.quantizer == nil {
+		v.vector = vector
+		return
+	}
+
+	v.code = h.quantizer.Encode(vector)
+	v.vector = nil
+}
+
+// compressIfNeeded compresses the index once it reaches the training size of its quantizer
+func (h *Hnsw) compressIfNeeded() {
+	if h.compression == nil {
+		return
+	}
+
+	h.maintenanceLock.RLock()
+	h.RLock()
+	needed := h.quantizer == nil && len(h.nodes) >= h.compression.trainingSize
+	h.RUnlock()
+	h.maintenanceLock.RUnlock()
+
+	if !needed {
+		return
+	}
+
+	if err := h.compress(); err != nil {
+		logrus.WithError(err).Error("failed compressing hnsw vectors")
+	}
+}
+
+// compress trains the quantizer on the indexed vectors, persists it and replaces the vectors of all
+// vertices with their codes. all other operations on the index are blocked meanwhile.
+func (h *Hnsw) compress() error {
+	h.maintenanceLock.Lock()
+	defer h.maintenanceLock.Unlock()
+
+	if h.quantizer != nil { // compressed by a concurrent insertion
+		return nil
+	}
+
+	sample := make([][]float32, 0, h.compression.trainingSize)
+	for id, v := range h.nodes {
+		if len(sample) == h.compression.trainingSize {
+			break
+		}
+
+		if _, deleted := h.deletedNodes[id]; deleted || v.vector == nil {
+			continue
+		}
+
+		sample = append(sample, v.vector)
+	}
+
+	if len(sample) == 0 {
+		return nil
+	}
+
+	q, err := h.compression.newQuantizer(len(sample[0]))
+	if err != nil {
+		return err
+	}
+
+	if err = q.Fit(sample); err != nil {
+		return errors.Wrap(err, "failed training quantizer")
+	}
+
+	b, err := q.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	if err = writeFileAtomically(h.quantizerPath, b); err != nil {
+		return fmt.Errorf("failed writing quantizer: %w", err)
+	}
+
+	h.quantizer = q
+
+	for _, v := range h.nodes {
+		if v.vector != nil {
+			h.setVector(v, v.vector)
+		}
+	}
+
+	return nil
+}
+
+// loadQuantizer restores the quantizer if the index was compressed
+func (h *Hnsw) loadQuantizer() error {
+	if h.compression == nil {
+		return nil
+	}
+
+	b, err := os.ReadFile(h.quantizerPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	q, err := h.compression.unmarshal(b)
+	if err != nil {
+		return errors.Wrapf(err, "failed loading quantizer from %s", h.quantizerPath)
+	}
+
+	h.quantizer = q
+
+	return nil
+}
+
+// rescoring reports whether the candidates of searches are rescored with the full vectors
+func (h *Hnsw) rescoring() bool {
+	return h.quantizer != nil && h.compression != nil && h.compression.rescore && h.store != nil
+}
+
+// rescore replaces the approximated distances of elems with the distances of q from the full vectors
+// in the vectors store and returns them sorted, elements whose vector was removed are dropped.
+func (h *Hnsw) rescore(q []float32, elems []utils.Element) []utils.Element {
+	if !h.rescoring() {
+		return elems
+	}
+
+	res := make([]utils.Element, 0, len(elems))
+
+	for _, e := range elems {
+		vector, found, err := h.store.GetVector(e.Id)
+		if err != nil {
+			logrus.WithError(err).Errorf("failed fetching vector %d for rescoring", e.Id)
+			res = append(res, e)
+
+			continue
+		}
+
+		if !found {
+			continue
+		}
+
+		res = append(res, utils.Element{Id: e.Id, Distance: h.calculateDistance(h.prepareVector(vector), q)})
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Distance < res[j].Distance
+	})
+
+	return res
+}
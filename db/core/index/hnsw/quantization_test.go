@@ -0,0 +1,144 @@
+package hnsw
+
+import (
+	"Vectory/db/core/objstore"
+	"Vectory/entities/index"
+	objstoreentities "Vectory/entities/objstore"
+	"Vectory/entities/search"
+	"github.com/stretchr/testify/require"
+	"math/rand"
+	"os"
+	"sort"
+	"testing"
+)
+
+func TestHnsw_Quantization(t *testing.T) {
+	size, dim, k, trainingSize := 1500, 32, 10, 500
+
+	vectors := make([][]float32, size)
+	for i := range vectors {
+		vectors[i] = make([]float32, dim)
+		for j := range vectors[i] {
+			vectors[i][j] = rand.Float32()
+		}
+	}
+
+	tests := []struct {
+		name     string
+		pq       *index.PQParams
+		sq       *index.SQParams
+		codeSize int
+		rescored bool
+		recall   float64
+	}{
+		{
+			name:     "pq",
+			pq:       &index.PQParams{Subspaces: 8, Centroids: 64, TrainingSize: trainingSize},
+			codeSize: 8,
+			rescored: true,
+			recall:   0.9,
+		},
+		{
+			name:     "sq with rescoring",
+			sq:       &index.SQParams{TrainingSize: trainingSize, Rescore: true},
+			codeSize: dim + 4,
+			rescored: true,
+			recall:   0.9,
+		},
+		{
+			name:     "sq",
+			sq:       &index.SQParams{TrainingSize: trainingSize},
+			codeSize: dim + 4,
+			recall:   0.85,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filesPath := "./tmp_quantization"
+			defer os.RemoveAll(filesPath)
+
+			store, err := objstore.NewStores(filesPath)
+			require.NoError(t, err)
+
+			params := index.DefaultHnswParams
+			params.PQ, params.SQ = tt.pq, tt.sq
+
+			h, err := NewHnsw(params, filesPath, store)
+			require.NoError(t, err)
+
+			for i, v := range vectors {
+				require.NoError(t, store.PutObject(&objstoreentities.Object{Id: uint64(i), Vector: v}))
+				require.NoError(t, h.Insert(v, uint64(i)))
+
+				if i == trainingSize-2 {
+					require.Nil(t, h.quantizer)
+				}
+			}
+
+			requireCompressed := func(t *testing.T, h *Hnsw) {
+				require.NotNil(t, h.quantizer)
+
+				for _, v := range h.nodes {
+					require.Nil(t, v.vector)
+					require.Len(t, v.code, tt.codeSize)
+				}
+			}
+
+			requireRecall := func(t *testing.T, h *Hnsw) {
+				var found int
+
+				for q := 0; q < 50; q++ {
+					query := vectors[rand.Intn(size)]
+
+					expected := make([]int, size)
+					for i := range expected {
+						expected[i] = i
+					}
+
+					sort.Slice(expected, func(i, j int) bool {
+						return h.calculateDistance(vectors[expected[i]], query) < h.calculateDistance(vectors[expected[j]], query)
+					})
+
+					res := h.Search(query, search.NewOptions(k))
+					require.Len(t, res, k)
+
+					if tt.rescored {
+						require.InDelta(t, 0, res[0].Distance, 1e-5)
+					}
+
+					for _, e := range res {
+						for _, id := range expected[:k] {
+							if e.Id == uint64(id) {
+								found++
+							}
+						}
+					}
+				}
+
+				require.Greater(t, float64(found)/float64(50*k), tt.recall)
+			}
+
+			t.Run("vertices are compressed", func(t *testing.T) {
+				requireCompressed(t, h)
+			})
+
+			t.Run("recall", func(t *testing.T) {
+				requireRecall(t, h)
+			})
+
+			t.Run("quantizer is restored", func(t *testing.T) {
+				require.NoError(t, h.Close())
+
+				restored, err := NewHnsw(params, filesPath, store)
+				require.NoError(t, err)
+				defer restored.Close()
+
+				requireCompressed(t, restored)
+				requireRecall(t, restored)
+			})
+
+			require.NoError(t, store.Close())
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package hnsw
+
+import (
+	"Vectory/db/core/index/utils"
+	"container/heap"
+	"math"
+	"sort"
+)
+
+// RangeSearch returns up to limit vertices within radius of q sorted by their distance. the bottom layer is
+// searched for the ef nearest vertices, and expanded from the ones within radius until the frontier exceeds it.
+func (h *Hnsw) RangeSearch(q []float32, radius float32, limit int) []utils.Element {
+	h.maintenanceLock.RLock()
+	defer h.maintenanceLock.RUnlock()
+
+	q = h.prepareVector(q)
+	d := h.newDistancer(q)
+
+	eps, ok := h.descend(d)
+	if !ok {
+		return []utils.Element{}
+	}
+
+	seeds := h.searchLayer(d, eps, h.ef, 0)
+
+	if !h.rescoring() {
+		return h.expandWithinRadius(d, seeds, radius, limit)
+	}
+
+	// when compressed, the approximated distances may exceed radius for vertices within it, so the layer is
+	// expanded within a radius relaxed by the approximation error of the seeds, and the vertices found are
+	// filtered by their distances from the full vectors. the approximated order differs from the exact one, so
+	// the expansion isn't limited.
+	res := h.rescore(q, h.expandWithinRadius(d, seeds, radius+2*h.approximationError(q, seeds), math.MaxInt))
+
+	for i, e := range res {
+		if e.Distance > radius {
+			res = res[:i]
+			break
+		}
+	}
+
+	if len(res) > limit {
+		res = res[:limit]
+	}
+
+	return res
+}
+
+// approximationError returns the largest difference between the approximated and the full distances of
+// elems from q.
+func (h *Hnsw) approximationError(q []float32, elems []utils.Element) float32 {
+	approximated := make(map[uint64]float32, len(elems))
+	for _, e := range elems {
+		approximated[e.Id] = e.Distance
+	}
+
+	var maxErr float32
+	for _, e := range h.rescore(q, elems) {
+		if err := e.Distance - approximated[e.Id]; err > maxErr {
+			maxErr = err
+		} else if -err > maxErr {
+			maxErr = -err
+		}
+	}
+
+	return maxErr
+}
+
+// expandWithinRadius runs a best-first traversal of the bottom layer from the seeds within radius, expanding
+// only vertices within radius, until the frontier exceeds radius or limit vertices were found.
+func (h *Hnsw) expandWithinRadius(d *distancer, seeds []utils.Element, radius float32, limit int) []utils.Element {
+	visited := newSet[uint64]()
+	within := make([]utils.Element, 0, len(seeds))
+
+	for _, e := range seeds {
+		visited.Add(e.Id)
+
+		if e.Distance <= radius {
+			within = append(within, e)
+		}
+	}
+
+	candidates := utils.NewMinHeapFromSliceDeep(within, len(within))
+	connections := make([]uint64, h.mMax0) // reused for all candidates
+	res := make([]utils.Element, 0, len(within))
+
+	for candidates.Len() > 0 && len(res) < limit {
+		c := heap.Pop(candidates).(utils.Element)
+
+		h.RLock()
+		cVertex := h.nodes[c.Id]
+		_, deleted := h.deletedNodes[c.Id]
+		h.RUnlock()
+
+		if !deleted { // deleted vertices are still traversed
+			res = append(res, c)
+		}
+
+		cVertex.Lock()
+		connections = connections[:len(cVertex.GetConnections(0))]
+		copy(connections, cVertex.GetConnections(0))
+		cVertex.Unlock()
+
+		for _, nid := range connections {
+			if visited.Contains(nid) {
+				continue
+			}
+
+			visited.Add(nid)
+
+			h.RLock()
+			neighbour := h.nodes[nid]
+			h.RUnlock()
+
+			if dist := d.distance(neighbour); dist <= radius {
+				heap.Push(candidates, utils.Element{Id: nid, Distance: dist})
+			}
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Distance < res[j].Distance
+	})
+
+	return res
+}
@@ -0,0 +1,151 @@
+package hnsw
+
+import (
+	"Vectory/db/core/objstore"
+	"Vectory/entities/index"
+	objstoreentities "Vectory/entities/objstore"
+	"github.com/stretchr/testify/require"
+	"math/rand"
+	"os"
+	"sort"
+	"testing"
+)
+
+func TestHnsw_RangeSearch(t *testing.T) {
+	filesPath := "./tmp_range"
+	defer os.RemoveAll(filesPath)
+
+	size, dim := 3000, 16
+
+	h, err := NewHnsw(index.DefaultHnswParams, filesPath, nil)
+	require.NoError(t, err)
+	defer h.Close()
+
+	require.Empty(t, h.RangeSearch(make([]float32, dim), 100, 10))
+
+	vectors := make([][]float32, size)
+	for i := range vectors {
+		vectors[i] = make([]float32, dim)
+		for j := range vectors[i] {
+			vectors[i][j] = rand.Float32()
+		}
+
+		require.NoError(t, h.Insert(vectors[i], uint64(i)))
+	}
+
+	q := vectors[rand.Intn(size)]
+
+	distances := make([]float32, size)
+	for i, v := range vectors {
+		distances[i] = h.calculateDistance(v, q)
+	}
+
+	sorted := append([]float32{}, distances...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	// the radius is wider than ef so the bottom layer must be expanded beyond the nearest ef vertices
+	radius := sorted[300]
+
+	t.Run("within radius", func(t *testing.T) {
+		res := h.RangeSearch(q, radius, size)
+
+		for i, e := range res {
+			require.LessOrEqual(t, e.Distance, radius)
+			require.Equal(t, distances[e.Id], e.Distance)
+
+			if i > 0 {
+				require.LessOrEqual(t, res[i-1].Distance, e.Distance)
+			}
+		}
+
+		require.Greater(t, float64(len(res))/301, 0.95)
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		res := h.RangeSearch(q, radius, 10)
+		require.Len(t, res, 10)
+	})
+
+	t.Run("deleted vertices are skipped", func(t *testing.T) {
+		res := h.RangeSearch(q, radius, size)
+		require.NoError(t, h.Delete(res[0].Id))
+
+		for _, e := range h.RangeSearch(q, radius, size) {
+			require.NotEqual(t, res[0].Id, e.Id)
+		}
+	})
+}
+
+func TestHnsw_RangeSearchCompressed(t *testing.T) {
+	size, dim, trainingSize := 3000, 32, 500
+
+	vectors := make([][]float32, size)
+	for i := range vectors {
+		vectors[i] = make([]float32, dim)
+		for j := range vectors[i] {
+			vectors[i][j] = rand.Float32()
+		}
+	}
+
+	tests := []struct {
+		name string
+		pq   *index.PQParams
+		sq   *index.SQParams
+	}{
+		{name: "pq", pq: &index.PQParams{Subspaces: 8, Centroids: 64, TrainingSize: trainingSize}},
+		{name: "sq", sq: &index.SQParams{TrainingSize: trainingSize, Rescore: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filesPath := "./tmp_range_compressed"
+			defer os.RemoveAll(filesPath)
+
+			store, err := objstore.NewStores(filesPath)
+			require.NoError(t, err)
+
+			params := index.DefaultHnswParams
+			params.PQ, params.SQ = tt.pq, tt.sq
+
+			h, err := NewHnsw(params, filesPath, store)
+			require.NoError(t, err)
+			defer h.Close()
+
+			for i, v := range vectors {
+				require.NoError(t, store.PutObject(&objstoreentities.Object{Id: uint64(i), Vector: v}))
+				require.NoError(t, h.Insert(v, uint64(i)))
+			}
+
+			require.True(t, h.rescoring())
+
+			var found, expected int
+			for q := 0; q < 20; q++ {
+				query := vectors[rand.Intn(size)]
+
+				distances := make([]float32, size)
+				for i, v := range vectors {
+					distances[i] = h.calculateDistance(v, query)
+				}
+
+				sorted := append([]float32{}, distances...)
+				sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+				radius := sorted[300]
+
+				res := h.RangeSearch(query, radius, size)
+				for _, e := range res {
+					require.LessOrEqual(t, e.Distance, radius)
+					require.InDelta(t, distances[e.Id], e.Distance, 1e-5)
+				}
+
+				found += len(res)
+				for _, dist := range distances {
+					if dist <= radius {
+						expected++
+					}
+				}
+			}
+
+			require.Greater(t, float64(found)/float64(expected), 0.95)
+		})
+	}
+}
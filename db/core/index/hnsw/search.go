@@ -2,38 +2,82 @@ package hnsw
 
 import (
 	"Vectory/db/core/index/utils"
+	"Vectory/entities/search"
 	"container/heap"
 )
 
-func (h *Hnsw) Search(q []float32, k int) []utils.Element {
-	var currentNearestElements []utils.Element
+// flatSearchCutoff is the allow-list size under which a filtered search scans the allowed vertices
+// instead of traversing the graph.
+const flatSearchCutoff = 1000
+
+// Search returns the k nearest neighbors of q within the maximum distance of opts.
+func (h *Hnsw) Search(q []float32, opts search.Options) []utils.Element {
+	h.maintenanceLock.RLock()
+	defer h.maintenanceLock.RUnlock()
+
+	return h.search(h.prepareVector(q), &opts, nil)
+}
 
-	dummy := Vertex{
-		vector: q,
+// SearchWithAllowList returns the k nearest neighbors of q out of the vertices in allowList.
+func (h *Hnsw) SearchWithAllowList(q []float32, opts search.Options, allowList *utils.Bitmap) []utils.Element {
+	h.maintenanceLock.RLock()
+	defer h.maintenanceLock.RUnlock()
+
+	q = h.prepareVector(q)
+
+	if allowList.Len() <= flatSearchCutoff {
+		return h.flatSearch(q, &opts, allowList)
 	}
 
+	return h.search(q, &opts, allowList)
+}
+
+// searchEf returns the size of the dynamic candidates list of a search with opts, which is at least k
+func (h *Hnsw) searchEf(opts *search.Options) int {
+	ef := h.ef
+	if opts.Ef > 0 {
+		ef = opts.Ef
+	}
+
+	if opts.K > ef {
+		ef = opts.K
+	}
+
+	return ef
+}
+
+// search for the k nearest neighbors of q. when allowList is not nil, only vertices in it are returned
+// but all vertices are traversed.
+func (h *Hnsw) search(q []float32, opts *search.Options, allowList *utils.Bitmap) []utils.Element {
+	var currentNearestElements []utils.Element
+
+	k := opts.K
+
 	res := make([]utils.Element, 0, k)
 
-	h.RLock()
-	entrypointID := h.entrypointID
-	epVertex := h.nodes[entrypointID]
-	currentMaxLayer := h.currentMaxLayer
-	h.RUnlock()
+	d := h.newDistancer(q)
 
-	dist := h.calculateDistance(epVertex.vector, q)
+	eps, ok := h.descend(d)
+	if !ok {
+		return res
+	}
 
-	eps := make([]utils.Element, 0, 1)
-	eps = append(eps, utils.Element{Id: entrypointID, Distance: dist})
+	ef := h.searchEf(opts)
 
-	for l := currentMaxLayer; l > 0; l-- {
-		currentNearestElements = h.searchLayer(&dummy, eps, 1, l)
-		eps[0] = currentNearestElements[0]
+	if allowList == nil {
+		currentNearestElements = h.searchLayer(d, eps, ef, 0)
+	} else {
+		currentNearestElements = h.searchLayerWithAllowList(d, eps, ef, allowList)
 	}
 
-	currentNearestElements = h.searchLayer(&dummy, eps, h.ef, 0)
+	// when compressed, the candidates found with approximated distances may be rescored with the full vectors
+	currentNearestElements = h.rescore(q, currentNearestElements)
 
 	minHeap := utils.NewMinHeapFromSlice(currentNearestElements)
 
+	h.RLock()
+	defer h.RUnlock()
+
 	var i int
 	for minHeap.Len() > 0 {
 		if i == k {
@@ -41,6 +85,10 @@ func (h *Hnsw) Search(q []float32, k int) []utils.Element {
 		}
 
 		e := heap.Pop(minHeap).(utils.Element)
+		if !opts.Within(e.Distance) {
+			break
+		}
+
 		if _, ok := h.deletedNodes[e.Id]; ok {
 			continue
 		}
@@ -52,7 +100,31 @@ func (h *Hnsw) Search(q []float32, k int) []utils.Element {
 	return res
 }
 
-func (h *Hnsw) searchLayer(v *Vertex, eps []utils.Element, ef int, level int64) []utils.Element {
+// descend greedily searches the upper layers for the nearest vertex to the query of d, which is the entrypoint
+// of the bottom layer. it reports false when the index is empty.
+func (h *Hnsw) descend(d *distancer) ([]utils.Element, bool) {
+	h.RLock()
+	if h.isEmpty() {
+		h.RUnlock()
+		return nil, false
+	}
+
+	entrypointID := h.entrypointID
+	epVertex := h.nodes[entrypointID]
+	currentMaxLayer := h.currentMaxLayer
+	h.RUnlock()
+
+	eps := make([]utils.Element, 0, 1)
+	eps = append(eps, utils.Element{Id: entrypointID, Distance: d.distance(epVertex)})
+
+	for l := currentMaxLayer; l > 0; l-- {
+		eps[0] = h.searchLayer(d, eps, 1, l)[0]
+	}
+
+	return eps, true
+}
+
+func (h *Hnsw) searchLayer(d *distancer, eps []utils.Element, ef int, level int64) []utils.Element {
 	visited := newSet[uint64]()
 	for _, e := range eps {
 		visited.Add(e.Id)
@@ -92,7 +164,7 @@ func (h *Hnsw) searchLayer(v *Vertex, eps []utils.Element, ef int, level int64)
 			neighbour := h.nodes[nid]
 			h.RUnlock()
 
-			dist := h.calculateDistance(neighbour.vector, v.vector)
+			dist := d.distance(neighbour)
 			if dist < f.Distance || nearestNeighbors.Len() < ef {
 				e := utils.Element{Id: nid, Distance: dist}
 
@@ -108,3 +180,117 @@ func (h *Hnsw) searchLayer(v *Vertex, eps []utils.Element, ef int, level int64)
 
 	return nearestNeighbors.Elements
 }
+
+// searchLayerWithAllowList searches the bottom layer like searchLayer, but only vertices in allowList
+// are kept as nearest neighbors while the rest are still used for traversing the graph.
+func (h *Hnsw) searchLayerWithAllowList(d *distancer, eps []utils.Element, ef int, allowList *utils.Bitmap) []utils.Element {
+	visited := newSet[uint64]()
+	candidates := utils.NewMinHeapFromSliceDeep(eps, ef+1)
+	nearestNeighbors := utils.NewMaxHeapFromSliceDeep(nil, ef+1)
+
+	for _, e := range eps {
+		visited.Add(e.Id)
+
+		if allowList.Contains(e.Id) {
+			heap.Push(nearestNeighbors, e)
+		}
+	}
+
+	connections := make([]uint64, h.mMax0) // reused for all candidates
+
+	for candidates.Len() > 0 {
+		c := heap.Pop(candidates).(utils.Element)
+
+		if nearestNeighbors.Len() >= ef && c.Distance > nearestNeighbors.Peek().(utils.Element).Distance {
+			break
+		}
+
+		h.RLock()
+		cVertex := h.nodes[c.Id]
+		h.RUnlock()
+
+		cVertex.Lock()
+		connections = connections[:len(cVertex.GetConnections(0))]
+		copy(connections, cVertex.GetConnections(0))
+		cVertex.Unlock()
+
+		for _, nid := range connections {
+			if visited.Contains(nid) {
+				continue
+			}
+
+			visited.Add(nid)
+
+			h.RLock()
+			neighbour := h.nodes[nid]
+			h.RUnlock()
+
+			dist := d.distance(neighbour)
+			if nearestNeighbors.Len() < ef || dist < nearestNeighbors.Peek().(utils.Element).Distance {
+				e := utils.Element{Id: nid, Distance: dist}
+
+				heap.Push(candidates, e)
+
+				if allowList.Contains(nid) {
+					heap.Push(nearestNeighbors, e)
+
+					if nearestNeighbors.Len() > ef {
+						heap.Pop(nearestNeighbors)
+					}
+				}
+			}
+		}
+	}
+
+	return nearestNeighbors.Elements
+}
+
+// flatSearch computes the distance of q from every vertex in allowList and returns the k nearest.
+// when rescoring, the ef nearest by approximated distance are rescored with the full vectors.
+func (h *Hnsw) flatSearch(q []float32, opts *search.Options, allowList *utils.Bitmap) []utils.Element {
+	k, n := opts.K, opts.K
+	if h.rescoring() {
+		n = h.searchEf(opts)
+	}
+
+	nearestNeighbors := utils.NewMaxHeapFromSliceDeep(nil, n+1)
+	d := h.newDistancer(q)
+
+	h.RLock()
+
+	for _, id := range allowList.ToSlice() {
+		v, ok := h.nodes[id]
+		if !ok {
+			continue
+		}
+
+		if _, deleted := h.deletedNodes[id]; deleted {
+			continue
+		}
+
+		heap.Push(nearestNeighbors, utils.Element{Id: id, Distance: d.distance(v)})
+		if nearestNeighbors.Len() > n {
+			heap.Pop(nearestNeighbors)
+		}
+	}
+
+	h.RUnlock()
+
+	res := make([]utils.Element, nearestNeighbors.Len())
+	for i := len(res) - 1; i >= 0; i-- {
+		res[i] = heap.Pop(nearestNeighbors).(utils.Element)
+	}
+
+	res = h.rescore(q, res)
+	if len(res) > k {
+		res = res[:k]
+	}
+
+	for i, e := range res {
+		if !opts.Within(e.Distance) {
+			return res[:i]
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,256 @@
+package hnsw
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"hash/crc32"
+	"os"
+	"time"
+)
+
+const (
+	snapshotMagic   uint32 = 0x534e4856 // "VHNS"
+	snapshotVersion uint32 = 1
+)
+
+var ErrCorruptedSnapshot = errors.New("corrupted hnsw snapshot")
+
+/*
+	snapshot layout, all integers are little endian:
+
+	header   = [magic uint32, version uint32, seqNum uint64, entrypointID uint64, currentMaxLayer uint32,
+	            len(nodes) uint64, len(deletedNodes) uint64]
+	vertex   = [id uint64, levels uint32, levels * [len(connections) uint32, connections * uint64]]
+	snapshot = [header, len(nodes) * vertex, len(deletedNodes) * id uint64, crc32 of everything before uint32]
+*/
+
+// snapshotPeriodically snapshots the graph every snapshotInterval until the index is closed.
+func (h *Hnsw) snapshotPeriodically() {
+	ticker := time.NewTicker(h.snapshotInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-h.stopMaintenance:
+			return
+		case <-ticker.C:
+			if err := h.snapshot(); err != nil {
+				logrus.WithError(err).Error("failed snapshotting hnsw")
+			}
+		}
+	}
+}
+
+// snapshot writes the graph to the snapshot file and truncates the WAL records it contains.
+// the graph is serialized while all other operations on the index are blocked, the file is written afterwards.
+func (h *Hnsw) snapshot() error {
+	h.snapshotMu.Lock()
+	defer h.snapshotMu.Unlock()
+
+	if h.closed {
+		return nil
+	}
+
+	h.maintenanceLock.Lock()
+
+	if err := h.wal.flush(); err != nil {
+		h.maintenanceLock.Unlock()
+		return err
+	}
+
+	seqNum, err := h.wal.lastSeqNum()
+	if err != nil {
+		h.maintenanceLock.Unlock()
+		return err
+	}
+
+	if seqNum == h.snapshotSeqNum { // nothing changed since the last snapshot
+		h.maintenanceLock.Unlock()
+		return nil
+	}
+
+	b := h.serializeSnapshot(seqNum)
+	h.maintenanceLock.Unlock()
+
+	return h.writeSnapshot(seqNum, b)
+}
+
+// writeSnapshot writes the serialized snapshot b of the graph at seqNum and truncates the WAL records it contains.
+func (h *Hnsw) writeSnapshot(seqNum uint64, b []byte) error {
+	if err := writeFileAtomically(h.snapshotPath, b); err != nil {
+		return fmt.Errorf("failed writing hnsw snapshot: %w", err)
+	}
+
+	h.snapshotSeqNum = seqNum
+
+	return h.wal.truncateUntil(seqNum)
+}
+
+func (h *Hnsw) serializeSnapshot(seqNum uint64) []byte {
+	size := 44 + 8*len(h.deletedNodes) + 4
+	for _, v := range h.nodes {
+		size += 12
+		for _, connections := range v.connections {
+			size += 4 + 8*len(connections)
+		}
+	}
+
+	b := make([]byte, size)
+
+	binary.LittleEndian.PutUint32(b[0:], snapshotMagic)
+	binary.LittleEndian.PutUint32(b[4:], snapshotVersion)
+	binary.LittleEndian.PutUint64(b[8:], seqNum)
+	binary.LittleEndian.PutUint64(b[16:], h.entrypointID)
+	binary.LittleEndian.PutUint32(b[24:], uint32(h.currentMaxLayer))
+	binary.LittleEndian.PutUint64(b[28:], uint64(len(h.nodes)))
+	binary.LittleEndian.PutUint64(b[36:], uint64(len(h.deletedNodes)))
+
+	offset := 44
+	for _, v := range h.nodes {
+		binary.LittleEndian.PutUint64(b[offset:], v.id)
+		binary.LittleEndian.PutUint32(b[offset+8:], uint32(len(v.connections)))
+		offset += 12
+
+		for _, connections := range v.connections {
+			binary.LittleEndian.PutUint32(b[offset:], uint32(len(connections)))
+			offset += 4
+
+			for _, n := range connections {
+				binary.LittleEndian.PutUint64(b[offset:], n)
+				offset += 8
+			}
+		}
+	}
+
+	for id := range h.deletedNodes {
+		binary.LittleEndian.PutUint64(b[offset:], id)
+		offset += 8
+	}
+
+	binary.LittleEndian.PutUint32(b[offset:], crc32.ChecksumIEEE(b[:offset]))
+
+	return b
+}
+
+// loadSnapshot restores the graph from the snapshot file if exists and returns the WAL sequence number it contains.
+func (h *Hnsw) loadSnapshot() (uint64, error) {
+	b, err := os.ReadFile(h.snapshotPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	if len(b) < 48 || binary.LittleEndian.Uint32(b[0:]) != snapshotMagic {
+		return 0, ErrCorruptedSnapshot
+	}
+
+	if version := binary.LittleEndian.Uint32(b[4:]); version != snapshotVersion {
+		return 0, fmt.Errorf("unsupported hnsw snapshot version %d", version)
+	}
+
+	end := len(b) - 4
+	if crc32.ChecksumIEEE(b[:end]) != binary.LittleEndian.Uint32(b[end:]) {
+		return 0, ErrCorruptedSnapshot
+	}
+
+	r := snapshotReader{b: b[:end], offset: 8}
+
+	seqNum := r.uint64()
+	entrypointID := r.uint64()
+	currentMaxLayer := int64(r.uint32())
+	nodesLen := r.uint64()
+	deletedLen := r.uint64()
+
+	nodes := make(map[uint64]*Vertex, nodesLen)
+	for i := uint64(0); i < nodesLen && r.err == nil; i++ {
+		v := Vertex{id: r.uint64()}
+		v.connections = make([][]uint64, r.uint32())
+
+		for l := range v.connections {
+			v.connections[l] = make([]uint64, r.uint32())
+			for j := range v.connections[l] {
+				v.connections[l][j] = r.uint64()
+			}
+		}
+
+		nodes[v.id] = &v
+	}
+
+	deletedNodes := make(map[uint64]struct{}, deletedLen)
+	for i := uint64(0); i < deletedLen && r.err == nil; i++ {
+		deletedNodes[r.uint64()] = struct{}{}
+	}
+
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	h.entrypointID = entrypointID
+	h.currentMaxLayer = currentMaxLayer
+	h.nodes = nodes
+	h.deletedNodes = deletedNodes
+	h.snapshotSeqNum = seqNum
+
+	return seqNum, nil
+}
+
+type snapshotReader struct {
+	b      []byte
+	offset int
+	err    error
+}
+
+func (r *snapshotReader) uint64() uint64 {
+	if r.err != nil || r.offset+8 > len(r.b) {
+		r.err = ErrCorruptedSnapshot
+		return 0
+	}
+
+	n := binary.LittleEndian.Uint64(r.b[r.offset:])
+	r.offset += 8
+
+	return n
+}
+
+func (r *snapshotReader) uint32() uint32 {
+	if r.err != nil || r.offset+4 > len(r.b) {
+		r.err = ErrCorruptedSnapshot
+		return 0
+	}
+
+	n := binary.LittleEndian.Uint32(r.b[r.offset:])
+	r.offset += 4
+
+	return n
+}
+
+// writeFileAtomically writes b to a temporary file and renames it to path once it is synced to disk.
+func writeFileAtomically(path string, b []byte) error {
+	tmp := path + ".tmp"
+
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0640)
+	if err != nil {
+		return err
+	}
+
+	if _, err = f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err = f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
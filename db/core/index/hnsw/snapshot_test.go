@@ -0,0 +1,97 @@
+package hnsw
+
+import (
+	"Vectory/db/core/objstore"
+	"Vectory/entities/index"
+	objstoreentities "Vectory/entities/objstore"
+	"github.com/stretchr/testify/require"
+	"math/rand"
+	"os"
+	"testing"
+)
+
+func TestHnsw_Snapshot(t *testing.T) {
+	filesPath := "./tmp_snapshot"
+	defer os.RemoveAll(filesPath)
+
+	store, err := objstore.NewStores(filesPath)
+	require.NoError(t, err)
+
+	h, err := NewHnsw(index.DefaultHnswParams, filesPath, store)
+	require.NoError(t, err)
+
+	size, dim := 1000, 32
+	insert := func(h *Hnsw, from, to int) {
+		for i := from; i < to; i++ {
+			vector := make([]float32, dim)
+			for j := range vector {
+				vector[j] = rand.Float32()
+			}
+
+			require.NoError(t, store.PutObject(&objstoreentities.Object{Id: uint64(i), Vector: vector}))
+			require.NoError(t, h.Insert(vector, uint64(i)))
+		}
+	}
+
+	requireSameGraph := func(t *testing.T, expected, actual *Hnsw) {
+		require.Equal(t, expected.entrypointID, actual.entrypointID)
+		require.Equal(t, expected.currentMaxLayer, actual.currentMaxLayer)
+		require.Equal(t, expected.deletedNodes, actual.deletedNodes)
+		require.Len(t, actual.nodes, len(expected.nodes))
+
+		for id, v := range expected.nodes {
+			require.Contains(t, actual.nodes, id)
+			require.Equal(t, v.connections, actual.nodes[id].connections)
+			require.Equal(t, v.vector, actual.nodes[id].vector)
+		}
+	}
+
+	insert(h, 0, size/2)
+	for i := 0; i < size/10; i++ {
+		require.NoError(t, h.Delete(uint64(rand.Intn(size/2))))
+	}
+
+	require.NoError(t, h.snapshot())
+
+	t.Run("WAL is truncated", func(t *testing.T) {
+		first, err := h.wal.f.FirstIndex()
+		require.NoError(t, err)
+		require.Equal(t, h.snapshotSeqNum, first)
+	})
+
+	insert(h, size/2, size)
+	require.NoError(t, h.Delete(uint64(size-1)))
+	require.NoError(t, h.Flush())
+
+	t.Run("restore from snapshot and WAL tail", func(t *testing.T) {
+		hRestored, err := NewHnsw(index.DefaultHnswParams, filesPath, store)
+		require.NoError(t, err)
+
+		requireSameGraph(t, h, hRestored)
+	})
+
+	t.Run("restore after close", func(t *testing.T) {
+		require.NoError(t, h.Close())
+
+		hRestored, err := NewHnsw(index.DefaultHnswParams, filesPath, store)
+		require.NoError(t, err)
+		defer hRestored.Close()
+
+		last, err := hRestored.wal.lastSeqNum()
+		require.NoError(t, err)
+		require.Equal(t, last, hRestored.snapshotSeqNum)
+
+		requireSameGraph(t, h, hRestored)
+	})
+
+	t.Run("corrupted snapshot", func(t *testing.T) {
+		b, err := os.ReadFile(h.snapshotPath)
+		require.NoError(t, err)
+
+		b[len(b)/2] ^= 0xff
+		require.NoError(t, os.WriteFile(h.snapshotPath, b, 0640))
+
+		_, err = NewHnsw(index.DefaultHnswParams, filesPath, store)
+		require.ErrorIs(t, err, ErrCorruptedSnapshot)
+	})
+}
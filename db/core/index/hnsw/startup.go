@@ -2,14 +2,37 @@ package hnsw
 
 import (
 	"Vectory/db/core/objstore"
-	"encoding/binary"
 	"github.com/pkg/errors"
 	"io"
 )
 
-// loadFromWAL builds index from wal if exists
-func (h *Hnsw) loadFromWAL() error {
-	r := h.wal.walReader()
+// load restores the index from its snapshot if exists and replays the WAL records written after it
+func (h *Hnsw) load() error {
+	seqNum, err := h.loadSnapshot()
+	if err != nil {
+		return errors.Wrapf(err, "failed loading snapshot from %s", h.snapshotPath)
+	}
+
+	first, err := h.wal.f.FirstIndex()
+	if err != nil {
+		return err
+	}
+
+	last, err := h.wal.lastSeqNum()
+	if err != nil {
+		return err
+	}
+
+	if seqNum > last || first > seqNum+1 {
+		return errors.Errorf("WAL [%d, %d] doesn't continue snapshot at %d", first, last, seqNum)
+	}
+
+	return h.loadFromWAL(seqNum + 1)
+}
+
+// loadFromWAL builds index from wal records starting at seqNum
+func (h *Hnsw) loadFromWAL(seqNum uint64) error {
+	r := h.wal.walReader(seqNum)
 	d := deserializer{state: h}
 
 	for {
@@ -37,17 +60,46 @@ func (h *Hnsw) populateVerticesVectors(store *objstore.Stores) error {
 		return nil
 	}
 
-	s := store.GetVectorsStore()
+	ids, err := store.VectorsIds()
+	if err != nil {
+		return err
+	}
 
-	for k := range s.Keys() {
-		id := binary.LittleEndian.Uint64(k)
+	for _, id := range ids {
 		vec, _, err := store.GetVector(id)
 		if err != nil {
 			return err
 		}
 
-		h.nodes[id].vector = vec
+		v, ok := h.nodes[id]
+		if !ok { // vertex was removed from the graph
+			continue
+		}
+
+		h.setVector(v, h.prepareVector(vec))
 	}
 
-	return nil
+	return h.removeVerticesWithoutVectors()
+}
+
+// removeVerticesWithoutVectors removes the vertices whose vectors are missing from the object storage, which
+// can't be traversed. it happens when the graph was persisted while the vectors of its objects weren't.
+func (h *Hnsw) removeVerticesWithoutVectors() error {
+	var missing bool
+
+	for id, v := range h.nodes {
+		if v.vector != nil || v.code != nil {
+			continue
+		}
+
+		h.wal.deleteVertex(id)
+		h.deletedNodes[id] = struct{}{}
+		missing = true
+	}
+
+	if !missing {
+		return nil
+	}
+
+	return h.cleanupTombstones()
 }
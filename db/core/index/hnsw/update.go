@@ -0,0 +1,104 @@
+package hnsw
+
+import (
+	"Vectory/db/core/index/utils"
+	"errors"
+)
+
+var ErrVertexNotFound = errors.New("vertex not found")
+
+// Update replaces the vector of the vertex with vectorId and repairs its neighborhood in place while keeping
+// the same id: the vertices around its old vector drop their connections to it, then it's linked to its new
+// nearest neighbors on every layer it exists in. all other operations on the index are blocked meanwhile.
+func (h *Hnsw) Update(vector []float32, vectorId uint64) error {
+	h.maintenanceLock.Lock()
+	defer h.maintenanceLock.Unlock()
+
+	h.RLock()
+	v, found := h.nodes[vectorId]
+	_, deleted := h.deletedNodes[vectorId]
+	entrypointID := h.entrypointID
+	currentMaxLayer := h.currentMaxLayer
+	size := len(h.nodes)
+	h.RUnlock()
+
+	if !found || deleted {
+		return ErrVertexNotFound
+	}
+
+	vector = h.prepareVector(vector)
+
+	v.Lock()
+	h.setVector(v, vector)
+	vertexLayer := int64(len(v.connections)) - 1
+	v.Unlock()
+
+	if size == 1 {
+		return nil
+	}
+
+	h.unlinkNeighbors(v, vertexLayer)
+	h.link(v, vector, vertexLayer, entrypointID, currentMaxLayer)
+
+	return nil
+}
+
+// unlinkNeighbors removes the connections to v of the vertices around v, since v's new vector may be far from
+// them, and replaces each of them with the nearest of v's neighbors the vertex isn't connected to yet. the
+// vertices connected to v are looked for among v's neighbors and their neighbors, where v was linked to.
+func (h *Hnsw) unlinkNeighbors(v *Vertex, vertexLayer int64) {
+	for l := vertexLayer; l >= 0; l-- {
+		neighbors := v.GetConnections(l)
+
+		around := newSet[uint64]()
+		for _, n := range neighbors {
+			around.Add(n)
+
+			for _, nn := range h.nodes[n].GetConnections(l) {
+				around.Add(nn)
+			}
+		}
+
+		for id := range around {
+			if id == v.id {
+				continue
+			}
+
+			nVertex := h.nodes[id]
+			connections := nVertex.GetConnections(l)
+			if !contains(connections, v.id) {
+				continue
+			}
+
+			replaced := make([]uint64, 0, len(connections))
+			for _, c := range connections {
+				if c != v.id {
+					replaced = append(replaced, c)
+				}
+			}
+
+			var (
+				found   bool
+				nearest utils.Element
+			)
+
+			for _, n := range neighbors {
+				if n == id || contains(replaced, n) {
+					continue
+				}
+
+				if dist := h.vertexDistance(nVertex, h.nodes[n]); !found || dist < nearest.Distance {
+					found = true
+					nearest = utils.Element{Id: n, Distance: dist}
+				}
+			}
+
+			if found {
+				replaced = append(replaced, nearest.Id)
+			}
+
+			h.wal.setConnectionsAtLevel(id, int(l), replaced)
+			nVertex.SetConnections(l, replaced)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package hnsw
+
+import (
+	"Vectory/db/core/objstore"
+	"Vectory/entities/distance"
+	"Vectory/entities/index"
+	objstoreentities "Vectory/entities/objstore"
+	"Vectory/entities/search"
+	"github.com/stretchr/testify/require"
+	"math/rand"
+	"os"
+	"sort"
+	"testing"
+)
+
+func TestHnsw_Update(t *testing.T) {
+	filesPath := "./tmp_update"
+	defer os.RemoveAll(filesPath)
+
+	store, err := objstore.NewStores(filesPath)
+	require.NoError(t, err)
+
+	params := index.HnswParams{M: 8, MMax: 16, EfConstruction: 100, Ef: 32, Heuristic: true, DistanceType: distance.Euclidean}
+
+	h, err := NewHnsw(params, filesPath, store)
+	require.NoError(t, err)
+
+	size, dim, k := 2000, 32, 10
+	vectors := make([][]float32, size)
+	newVector := func(id int) {
+		vectors[id] = make([]float32, dim)
+		for j := range vectors[id] {
+			vectors[id][j] = rand.Float32()
+		}
+
+		require.NoError(t, store.PutObject(&objstoreentities.Object{Id: uint64(id), Vector: vectors[id]}))
+	}
+
+	for i := 0; i < size; i++ {
+		newVector(i)
+		require.NoError(t, h.Insert(vectors[i], uint64(i)))
+	}
+
+	// every vector is moved several times, so the old neighborhoods are left with stale connections
+	// unless they are repaired
+	for round := 0; round < 5; round++ {
+		for _, i := range rand.Perm(size) {
+			newVector(i)
+			require.NoError(t, h.Update(vectors[i], uint64(i)))
+		}
+	}
+
+	t.Run("updated vertices are found by their new vectors", func(t *testing.T) {
+		var found int
+		for i := 0; i < size; i++ {
+			res := h.Search(vectors[i], search.NewOptions(1))
+			if len(res) > 0 && res[0].Id == uint64(i) {
+				found++
+			}
+		}
+
+		require.Greater(t, float64(found)/float64(size), 0.95)
+	})
+
+	t.Run("recall is close to the one of an index built from the new vectors", func(t *testing.T) {
+		rebuiltPath := "./tmp_update_rebuilt"
+		defer os.RemoveAll(rebuiltPath)
+
+		rebuilt, err := NewHnsw(params, rebuiltPath, store)
+		require.NoError(t, err)
+
+		for i := 0; i < size; i++ {
+			require.NoError(t, rebuilt.Insert(vectors[i], uint64(i)))
+		}
+
+		queries := make([][]float32, 100)
+		for q := range queries {
+			queries[q] = make([]float32, dim)
+			for j := range queries[q] {
+				queries[q][j] = rand.Float32()
+			}
+		}
+
+		recall := func(h *Hnsw) float64 {
+			var found int
+
+			for _, query := range queries {
+				expected := make([]int, size)
+				for i := range expected {
+					expected[i] = i
+				}
+
+				sort.Slice(expected, func(i, j int) bool {
+					return h.calculateDistance(vectors[expected[i]], query) < h.calculateDistance(vectors[expected[j]], query)
+				})
+
+				res := h.Search(query, search.NewOptions(k))
+				require.Len(t, res, k)
+
+				for _, e := range res {
+					for _, id := range expected[:k] {
+						if e.Id == uint64(id) {
+							found++
+						}
+					}
+				}
+			}
+
+			return float64(found) / float64(len(queries)*k)
+		}
+
+		require.Greater(t, recall(h), recall(rebuilt)-0.1)
+	})
+
+	t.Run("restore from WAL", func(t *testing.T) {
+		require.NoError(t, h.Flush())
+
+		hRestored, err := NewHnsw(params, filesPath, store)
+		require.NoError(t, err)
+
+		require.Equal(t, h.entrypointID, hRestored.entrypointID)
+		require.Equal(t, h.nodes, hRestored.nodes)
+	})
+
+	t.Run("unknown vertex", func(t *testing.T) {
+		require.ErrorIs(t, h.Update(vectors[0], uint64(size)), ErrVertexNotFound)
+	})
+}
@@ -1,6 +1,8 @@
 package hnsw
 
 import (
+	"Vectory/db/core/index/distance"
+	"Vectory/db/core/index/utils"
 	"math"
 	"math/rand"
 )
@@ -9,6 +11,15 @@ func (h *Hnsw) calculateDistance(v1, v2 []float32) float32 {
 	return h.distFunc(v1, v2)
 }
 
+// prepareVector normalizes vector when the distance function expects normalized vectors
+func (h *Hnsw) prepareVector(vector []float32) []float32 {
+	if !h.normalize {
+		return vector
+	}
+
+	return distance.Normalize(vector)
+}
+
 func (h *Hnsw) isEmpty() bool {
 	return len(h.nodes) == 0
 }
@@ -41,3 +52,25 @@ func (s Set[T]) Contains(elem T) bool {
 	_, ok := s[elem]
 	return ok
 }
+
+func contains(ids []uint64, id uint64) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// excludeVertex filters out the element with id from elems.
+func excludeVertex(elems []utils.Element, id uint64) []utils.Element {
+	filtered := make([]utils.Element, 0, len(elems))
+	for _, e := range elems {
+		if e.Id != id {
+			filtered = append(filtered, e)
+		}
+	}
+
+	return filtered
+}
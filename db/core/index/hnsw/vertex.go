@@ -9,6 +9,7 @@ type Vertex struct {
 	id          uint64
 	connections [][]uint64
 	vector      []float32
+	code        []byte // product quantization code, replaces vector once the index is compressed
 }
 
 func (v *Vertex) Init(level int64, mMax, mMax0 int) {
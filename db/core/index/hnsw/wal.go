@@ -6,6 +6,7 @@ import (
 	w "github.com/tidwall/wal"
 	"io"
 	"sync"
+	"sync/atomic"
 )
 
 const (
@@ -14,13 +15,15 @@ const (
 	SetConnectionsAtLevel
 	addConnectionAtLevel
 	deleteVertex
+	removeVertex
 )
 
 type wal struct {
-	mu     sync.RWMutex
-	f      *w.Log
-	batch  *w.Batch
-	seqNum uint64
+	mu       sync.RWMutex
+	f        *w.Log
+	batch    *w.Batch
+	seqNum   uint64
+	disabled atomic.Bool // records aren't written while the graph is bulk built
 }
 
 func newWal(path string) (*wal, error) {
@@ -42,6 +45,9 @@ func newWal(path string) (*wal, error) {
 }
 
 func (w *wal) flush() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
 	if err := w.f.WriteBatch(w.batch); err != nil {
 		return err
 	}
@@ -128,11 +134,58 @@ func (w *wal) deleteVertex(id uint64) {
 	w.writeBatch(bytes)
 }
 
+func (w *wal) removeVertex(id uint64) {
+	/*
+		bytes = [opcode, id] = 1 + 8
+	*/
+
+	bytes := make([]byte, 9)
+
+	bytes[0] = removeVertex
+	binary.LittleEndian.PutUint64(bytes[1:9], id)
+
+	w.writeBatch(bytes)
+}
+
+func (w *wal) close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.f.Close()
+}
+
+// lastSeqNum returns the sequence number of the last record flushed to the WAL
+func (w *wal) lastSeqNum() (uint64, error) {
+	return w.f.LastIndex()
+}
+
+// truncateUntil removes all records before seqNum from the WAL, the record at seqNum is kept
+// since the WAL can't be left empty
+func (w *wal) truncateUntil(seqNum uint64) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	first, err := w.f.FirstIndex()
+	if err != nil {
+		return err
+	}
+
+	if seqNum <= first {
+		return nil
+	}
+
+	return errors.Wrapf(w.f.TruncateFront(seqNum), "failed truncating WAL until %d", seqNum)
+}
+
 func (w *wal) read(seqNum uint64) ([]byte, error) {
 	return w.f.Read(seqNum)
 }
 
 func (w *wal) writeBatch(data []byte) {
+	if w.disabled.Load() {
+		return
+	}
+
 	w.mu.Lock()
 	defer w.mu.Unlock()
 
@@ -145,10 +198,11 @@ type walReader struct {
 	pos uint64
 }
 
-func (w *wal) walReader() *walReader {
+// walReader returns a reader over the WAL records starting at seqNum
+func (w *wal) walReader(seqNum uint64) *walReader {
 	return &walReader{
 		wal: w,
-		pos: 1,
+		pos: seqNum,
 	}
 }
 
@@ -1,17 +1,53 @@
 package index
 
-import "Vectory/db/core/index/utils"
+import (
+	"Vectory/db/core/index/utils"
+	"Vectory/entities/search"
+)
 
 type VectorIndex interface {
 	// Insert a new vector and its corresponding objId
 	Insert(vector []float32, vectorId uint64) error
 
+	// Update the vector of the vertex corresponding with vectorId
+	Update(vector []float32, vectorId uint64) error
+
 	// Delete vertex corresponding with objId
 	Delete(id uint64) error
 
-	// Search for K-NN of vector
-	Search(q []float32, k int) []utils.Element
+	// Search for K-NN of vector, tuned and limited by opts
+	Search(q []float32, opts search.Options) []utils.Element
+
+	// SearchWithAllowList for K-NN of vector among the vertices in allowList
+	SearchWithAllowList(q []float32, opts search.Options, allowList *utils.Bitmap) []utils.Element
+
+	// RangeSearch for up to limit vectors within radius of q
+	RangeSearch(q []float32, radius float32, limit int) []utils.Element
+
+	// Ids of the vectors in the index, excluding the deleted ones
+	Ids() []uint64
+
+	// Pause flushes the WAL and keeps the index's files unmodified until Resume is called, searches are still served
+	Pause() error
+
+	// Resume the modifications of the index's files after Pause
+	Resume()
+
+	// Compact removes the deleted vectors from the index's graph and files
+	Compact() error
 
 	// Flush WAL to disk
 	Flush() error
+
+	// Close releases the resources held by the index
+	Close() error
+}
+
+// BulkBuilder is implemented by the indexes that are built faster when their modifications are persisted at once
+type BulkBuilder interface {
+	// BeginBulk stops persisting each modification of the index until EndBulk
+	BeginBulk() error
+
+	// EndBulk persists the modifications made since BeginBulk at once
+	EndBulk() error
 }
@@ -0,0 +1,169 @@
+package inverted
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+const (
+	// k1 controls the saturation of term frequencies
+	k1 = 1.2
+
+	// b controls how much scores are normalized by document length
+	b = 0.75
+)
+
+// Hit is a document matching a keyword query along with its BM25 score
+type Hit struct {
+	Id    uint64
+	Score float32
+}
+
+type document struct {
+	terms  map[string]uint32 // term frequencies
+	length uint32
+}
+
+// Index is an in memory inverted index scoring documents with BM25
+type Index struct {
+	sync.RWMutex
+	postings    map[string]map[uint64]uint32 // postings[term][id] is the frequency of term in document id
+	docs        map[uint64]*document
+	totalLength uint64
+}
+
+func NewIndex() *Index {
+	return &Index{
+		postings: make(map[string]map[uint64]uint32),
+		docs:     make(map[uint64]*document),
+	}
+}
+
+// Tokenize lower cases text and splits it into terms of letters and digits
+func Tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// Add indexes the terms of texts as document id, replacing it if it's already indexed
+func (idx *Index) Add(id uint64, texts []string) {
+	doc := document{terms: make(map[string]uint32)}
+	for _, text := range texts {
+		for _, term := range Tokenize(text) {
+			doc.terms[term]++
+			doc.length++
+		}
+	}
+
+	idx.Lock()
+	defer idx.Unlock()
+
+	idx.delete(id)
+
+	if doc.length == 0 {
+		return
+	}
+
+	for term, freq := range doc.terms {
+		postings, ok := idx.postings[term]
+		if !ok {
+			postings = make(map[uint64]uint32)
+			idx.postings[term] = postings
+		}
+
+		postings[id] = freq
+	}
+
+	idx.docs[id] = &doc
+	idx.totalLength += uint64(doc.length)
+}
+
+// Delete removes document id from the index
+func (idx *Index) Delete(id uint64) {
+	idx.Lock()
+	defer idx.Unlock()
+
+	idx.delete(id)
+}
+
+func (idx *Index) delete(id uint64) {
+	doc, ok := idx.docs[id]
+	if !ok {
+		return
+	}
+
+	for term := range doc.terms {
+		delete(idx.postings[term], id)
+
+		if len(idx.postings[term]) == 0 {
+			delete(idx.postings, term)
+		}
+	}
+
+	delete(idx.docs, id)
+	idx.totalLength -= uint64(doc.length)
+}
+
+// Len returns the number of indexed documents
+func (idx *Index) Len() int {
+	idx.RLock()
+	defer idx.RUnlock()
+
+	return len(idx.docs)
+}
+
+// Search returns the documents containing any of the terms of query sorted by their BM25 score
+func (idx *Index) Search(query string) []Hit {
+	idx.RLock()
+	defer idx.RUnlock()
+
+	if len(idx.docs) == 0 {
+		return []Hit{}
+	}
+
+	n := float64(len(idx.docs))
+	avgLength := float64(idx.totalLength) / n
+	scores := make(map[uint64]float64)
+
+	seen := make(map[string]struct{})
+	for _, term := range Tokenize(query) {
+		if _, ok := seen[term]; ok {
+			continue
+		}
+
+		seen[term] = struct{}{}
+
+		postings := idx.postings[term]
+		if len(postings) == 0 {
+			continue
+		}
+
+		df := float64(len(postings))
+		idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+
+		for id, freq := range postings {
+			tf := float64(freq)
+			norm := k1 * (1 - b + b*float64(idx.docs[id].length)/avgLength)
+			scores[id] += idf * tf * (k1 + 1) / (tf + norm)
+		}
+	}
+
+	hits := make([]Hit, 0, len(scores))
+	for id, score := range scores {
+		hits = append(hits, Hit{Id: id, Score: float32(score)})
+	}
+
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score == hits[j].Score {
+			return hits[i].Id < hits[j].Id
+		}
+
+		return hits[i].Score > hits[j].Score
+	})
+
+	return hits
+}
@@ -0,0 +1,63 @@
+package inverted
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	require.Equal(t, []string{"the", "sku", "x100", "is", "café"}, Tokenize("The SKU-X100, is  Café!"))
+	require.Empty(t, Tokenize(" ,.- "))
+}
+
+func TestIndex(t *testing.T) {
+	idx := NewIndex()
+
+	idx.Add(1, []string{"red wine from italy"})
+	idx.Add(2, []string{"white wine from france", "crisp"})
+	idx.Add(3, []string{"a long description of a red red wine from the hills of tuscany in italy"})
+	idx.Add(4, []string{"sparkling water"})
+	require.Equal(t, 4, idx.Len())
+
+	t.Run("rare terms score higher", func(t *testing.T) {
+		hits := idx.Search("wine france")
+		require.Len(t, hits, 3)
+		require.Equal(t, uint64(2), hits[0].Id)
+	})
+
+	t.Run("shorter documents score higher", func(t *testing.T) {
+		hits := idx.Search("italy")
+		require.Len(t, hits, 2)
+		require.Equal(t, uint64(1), hits[0].Id)
+		require.Equal(t, uint64(3), hits[1].Id)
+		require.Greater(t, hits[0].Score, hits[1].Score)
+	})
+
+	t.Run("no matches", func(t *testing.T) {
+		require.Empty(t, idx.Search("beer"))
+		require.Empty(t, idx.Search(""))
+	})
+
+	t.Run("replace", func(t *testing.T) {
+		idx.Add(4, []string{"sparkling wine from france"})
+		require.Equal(t, 4, idx.Len())
+		require.Empty(t, idx.Search("water"))
+		require.Len(t, idx.Search("sparkling"), 1)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		idx.Delete(2)
+		idx.Delete(2)
+		require.Equal(t, 3, idx.Len())
+		require.Empty(t, idx.Search("crisp"))
+
+		hits := idx.Search("france")
+		require.Len(t, hits, 1)
+		require.Equal(t, uint64(4), hits[0].Id)
+	})
+
+	t.Run("empty documents are not indexed", func(t *testing.T) {
+		idx.Add(5, []string{"", "--"})
+		require.Equal(t, 3, idx.Len())
+	})
+}
@@ -0,0 +1,45 @@
+package quantization
+
+import "Vectory/db/core/index/distance"
+
+var _ Quantizer = &BinaryQuantizer{}
+
+// BinaryQuantizer packs binary vectors to bits without loss, distances between them are hamming distances
+// calculated with popcount. it requires no training and has no state to persist.
+type BinaryQuantizer struct{}
+
+func NewBinaryQuantizer() *BinaryQuantizer {
+	return &BinaryQuantizer{}
+}
+
+func (bq *BinaryQuantizer) Fit(_ [][]float32) error {
+	return nil
+}
+
+func (bq *BinaryQuantizer) Encode(v []float32) []byte {
+	return distance.PackBits(v)
+}
+
+func (bq *BinaryQuantizer) CodeDistance(a, b []byte) float32 {
+	return float32(distance.HammingBits(a, b))
+}
+
+func (bq *BinaryQuantizer) VectorDistance(v []float32, code []byte) float32 {
+	return bq.CodeDistance(bq.Encode(v), code)
+}
+
+type binaryQueryDistancer struct {
+	code []byte
+}
+
+func (bq *BinaryQuantizer) NewQueryDistancer(q []float32) QueryDistancer {
+	return &binaryQueryDistancer{code: bq.Encode(q)}
+}
+
+func (d *binaryQueryDistancer) Distance(code []byte) float32 {
+	return float32(distance.HammingBits(d.code, code))
+}
+
+func (bq *BinaryQuantizer) MarshalBinary() ([]byte, error) {
+	return nil, nil
+}
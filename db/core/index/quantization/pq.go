@@ -0,0 +1,357 @@
+package quantization
+
+import (
+	"Vectory/entities/distance"
+	"encoding/binary"
+	"errors"
+	"math"
+	"math/rand"
+	"sync"
+)
+
+const (
+	// MaxCentroids is the maximum number of centroids in a subspace, so a code of a subspace fits in a byte
+	MaxCentroids = 256
+
+	kmeansIterations = 25
+)
+
+var ErrCorruptedCodebooks = errors.New("corrupted product quantization codebooks")
+
+var _ Quantizer = &ProductQuantizer{}
+
+// ProductQuantizer splits vectors into subspaces and encodes every sub-vector as the id of its nearest
+// centroid out of the centroids trained for the subspace, so a vector is compressed to a byte per subspace.
+type ProductQuantizer struct {
+	dim          int
+	subspaces    int
+	centroids    int
+	distanceType string
+	offsets      []int       // offsets[i] is the first dimension of subspace i, offsets[subspaces] = dim
+	codebooks    [][]float32 // codebooks[i] holds the centroids of subspace i one after the other
+}
+
+func NewProductQuantizer(dim, subspaces, centroids int, distanceType string) (*ProductQuantizer, error) {
+	if subspaces <= 0 || subspaces > dim {
+		return nil, errors.New("number of subspaces must be between 1 and the vectors dimension")
+	}
+
+	if centroids <= 0 || centroids > MaxCentroids {
+		return nil, errors.New("number of centroids must be between 1 and 256")
+	}
+
+	pq := ProductQuantizer{
+		dim:          dim,
+		subspaces:    subspaces,
+		centroids:    centroids,
+		distanceType: distanceType,
+		codebooks:    make([][]float32, subspaces),
+	}
+
+	pq.initOffsets()
+
+	return &pq, nil
+}
+
+// initOffsets divides the dimensions between the subspaces as evenly as possible
+func (pq *ProductQuantizer) initOffsets() {
+	pq.offsets = make([]int, pq.subspaces+1)
+	for i := range pq.offsets {
+		pq.offsets[i] = i * pq.dim / pq.subspaces
+	}
+}
+
+func (pq *ProductQuantizer) subDim(subspace int) int {
+	return pq.offsets[subspace+1] - pq.offsets[subspace]
+}
+
+func (pq *ProductQuantizer) centroid(subspace int, c byte) []float32 {
+	subDim := pq.subDim(subspace)
+	return pq.codebooks[subspace][int(c)*subDim : (int(c)+1)*subDim]
+}
+
+// Fit trains the codebooks of every subspace by running k-means over the sub-vectors of vectors
+func (pq *ProductQuantizer) Fit(vectors [][]float32) error {
+	if len(vectors) == 0 {
+		return errors.New("no vectors to train on")
+	}
+
+	for _, v := range vectors {
+		if len(v) != pq.dim {
+			return errors.New("training vector dimension doesn't match the quantizer dimension")
+		}
+	}
+
+	var wg sync.WaitGroup
+
+	for s := 0; s < pq.subspaces; s++ {
+		wg.Add(1)
+
+		go func(s int) {
+			defer wg.Done()
+
+			pq.codebooks[s] = pq.kmeans(vectors, s, rand.New(rand.NewSource(int64(s))))
+		}(s)
+	}
+
+	wg.Wait()
+
+	return nil
+}
+
+// kmeans returns the centroids of the sub-vectors of subspace, initialized to random sub-vectors
+func (pq *ProductQuantizer) kmeans(vectors [][]float32, subspace int, r *rand.Rand) []float32 {
+	start, end := pq.offsets[subspace], pq.offsets[subspace+1]
+	subDim := end - start
+
+	centroids := make([]float32, pq.centroids*subDim)
+	for c := 0; c < pq.centroids; c++ {
+		copy(centroids[c*subDim:], vectors[r.Intn(len(vectors))][start:end])
+	}
+
+	assignments := make([]int, len(vectors))
+	sums := make([]float32, len(centroids))
+	counts := make([]int, pq.centroids)
+
+	for it := 0; it < kmeansIterations; it++ {
+		var changed bool
+
+		for i, v := range vectors {
+			c := nearestCentroid(v[start:end], centroids, subDim)
+			if c != assignments[i] || it == 0 {
+				changed = true
+				assignments[i] = c
+			}
+		}
+
+		if !changed {
+			break
+		}
+
+		for i := range sums {
+			sums[i] = 0
+		}
+
+		for i := range counts {
+			counts[i] = 0
+		}
+
+		for i, v := range vectors {
+			c := assignments[i]
+			counts[c]++
+
+			for j, x := range v[start:end] {
+				sums[c*subDim+j] += x
+			}
+		}
+
+		for c := 0; c < pq.centroids; c++ {
+			if counts[c] == 0 { // an empty cluster is restarted at a random sub-vector
+				copy(centroids[c*subDim:], vectors[r.Intn(len(vectors))][start:end])
+				continue
+			}
+
+			for j := 0; j < subDim; j++ {
+				centroids[c*subDim+j] = sums[c*subDim+j] / float32(counts[c])
+			}
+		}
+	}
+
+	return centroids
+}
+
+func nearestCentroid(v, centroids []float32, subDim int) int {
+	var (
+		nearest int
+		minDist float32 = math.MaxFloat32
+	)
+
+	for c := 0; c*subDim < len(centroids); c++ {
+		if d := squaredEuclidean(v, centroids[c*subDim:(c+1)*subDim]); d < minDist {
+			nearest, minDist = c, d
+		}
+	}
+
+	return nearest
+}
+
+// Encode returns the code of v, which is the nearest centroid of every sub-vector
+func (pq *ProductQuantizer) Encode(v []float32) []byte {
+	code := make([]byte, pq.subspaces)
+
+	for s := 0; s < pq.subspaces; s++ {
+		code[s] = byte(nearestCentroid(v[pq.offsets[s]:pq.offsets[s+1]], pq.codebooks[s], pq.subDim(s)))
+	}
+
+	return code
+}
+
+// Decode returns the approximation of the vector encoded to code
+func (pq *ProductQuantizer) Decode(code []byte) []float32 {
+	v := make([]float32, 0, pq.dim)
+
+	for s, c := range code {
+		v = append(v, pq.centroid(s, c)...)
+	}
+
+	return v
+}
+
+// DistanceTable holds the distances of a query from every centroid, so the asymmetric distance of the query
+// from an encoded vector is calculated by summing a table lookup per subspace.
+type DistanceTable struct {
+	pq        *ProductQuantizer
+	distances []float32 // distances[s*centroids+c] is the distance of the query's sub-vector s from centroid c
+}
+
+// NewDistanceTable returns the distance table of q
+func (pq *ProductQuantizer) NewDistanceTable(q []float32) *DistanceTable {
+	t := DistanceTable{
+		pq:        pq,
+		distances: make([]float32, pq.subspaces*pq.centroids),
+	}
+
+	for s := 0; s < pq.subspaces; s++ {
+		for c := 0; c < pq.centroids; c++ {
+			t.distances[s*pq.centroids+c] = pq.subDistance(q[pq.offsets[s]:pq.offsets[s+1]], pq.centroid(s, byte(c)))
+		}
+	}
+
+	return &t
+}
+
+func (pq *ProductQuantizer) NewQueryDistancer(q []float32) QueryDistancer {
+	return pq.NewDistanceTable(q)
+}
+
+// Distance returns the distance of the table's query from the vector encoded to code
+func (t *DistanceTable) Distance(code []byte) float32 {
+	var sum float32
+	for s, c := range code {
+		sum += t.distances[s*t.pq.centroids+int(c)]
+	}
+
+	return t.pq.aggregate(sum)
+}
+
+// CodeDistance returns the distance between the vectors encoded to a and b
+func (pq *ProductQuantizer) CodeDistance(a, b []byte) float32 {
+	var sum float32
+	for s := range a {
+		sum += pq.subDistance(pq.centroid(s, a[s]), pq.centroid(s, b[s]))
+	}
+
+	return pq.aggregate(sum)
+}
+
+// VectorDistance returns the distance of v from the vector encoded to code
+func (pq *ProductQuantizer) VectorDistance(v []float32, code []byte) float32 {
+	var sum float32
+	for s, c := range code {
+		sum += pq.subDistance(v[pq.offsets[s]:pq.offsets[s+1]], pq.centroid(s, c))
+	}
+
+	return pq.aggregate(sum)
+}
+
+// subDistance returns the part of the distance between two vectors contributed by a subspace
+func (pq *ProductQuantizer) subDistance(a, b []float32) float32 {
+	switch pq.distanceType {
+	case distance.DotProduct, distance.Cosine:
+		return dot(a, b)
+	case distance.Manhattan:
+		return manhattan(a, b)
+	default:
+		return squaredEuclidean(a, b)
+	}
+}
+
+// aggregate returns the distance between two vectors out of the sum of their subspaces distances
+func (pq *ProductQuantizer) aggregate(sum float32) float32 {
+	switch pq.distanceType {
+	case distance.DotProduct, distance.Manhattan:
+		return sum
+	case distance.Cosine:
+		return 1 - sum
+	default:
+		return float32(math.Sqrt(float64(sum)))
+	}
+}
+
+func dot(a, b []float32) float32 {
+	var sum float32
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+
+	return sum
+}
+
+func manhattan(a, b []float32) float32 {
+	var sum float32
+	for i := range a {
+		sum += float32(math.Abs(float64(a[i] - b[i])))
+	}
+
+	return sum
+}
+
+func squaredEuclidean(a, b []float32) float32 {
+	var sum float32
+	for i := range a {
+		d := a[i] - b[i]
+		sum += d * d
+	}
+
+	return sum
+}
+
+// MarshalBinary serializes the codebooks as [dim, subspaces, centroids, codebooks...]
+func (pq *ProductQuantizer) MarshalBinary() ([]byte, error) {
+	buff := make([]byte, 12+4*pq.centroids*pq.dim)
+
+	binary.LittleEndian.PutUint32(buff[0:], uint32(pq.dim))
+	binary.LittleEndian.PutUint32(buff[4:], uint32(pq.subspaces))
+	binary.LittleEndian.PutUint32(buff[8:], uint32(pq.centroids))
+
+	offset := 12
+	for _, codebook := range pq.codebooks {
+		for _, f := range codebook {
+			binary.LittleEndian.PutUint32(buff[offset:], math.Float32bits(f))
+			offset += 4
+		}
+	}
+
+	return buff, nil
+}
+
+// UnmarshalProductQuantizer deserializes a quantizer serialized by MarshalBinary
+func UnmarshalProductQuantizer(buff []byte, distanceType string) (*ProductQuantizer, error) {
+	if len(buff) < 12 {
+		return nil, ErrCorruptedCodebooks
+	}
+
+	dim := int(binary.LittleEndian.Uint32(buff[0:]))
+	subspaces := int(binary.LittleEndian.Uint32(buff[4:]))
+	centroids := int(binary.LittleEndian.Uint32(buff[8:]))
+
+	pq, err := NewProductQuantizer(dim, subspaces, centroids, distanceType)
+	if err != nil {
+		return nil, ErrCorruptedCodebooks
+	}
+
+	if len(buff) != 12+4*centroids*dim {
+		return nil, ErrCorruptedCodebooks
+	}
+
+	offset := 12
+	for s := range pq.codebooks {
+		pq.codebooks[s] = make([]float32, centroids*pq.subDim(s))
+		for i := range pq.codebooks[s] {
+			pq.codebooks[s][i] = math.Float32frombits(binary.LittleEndian.Uint32(buff[offset:]))
+			offset += 4
+		}
+	}
+
+	return pq, nil
+}
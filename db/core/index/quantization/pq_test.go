@@ -0,0 +1,83 @@
+package quantization
+
+import (
+	"Vectory/entities/distance"
+	"github.com/stretchr/testify/require"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestProductQuantizer(t *testing.T) {
+	size, dim := 1000, 30
+
+	vectors := make([][]float32, size)
+	for i := range vectors {
+		vectors[i] = make([]float32, dim)
+		for j := range vectors[i] {
+			vectors[i][j] = rand.Float32()
+		}
+	}
+
+	t.Run("invalid configuration", func(t *testing.T) {
+		_, err := NewProductQuantizer(dim, dim+1, 16, distance.Euclidean)
+		require.Error(t, err)
+
+		_, err = NewProductQuantizer(dim, 4, MaxCentroids+1, distance.Euclidean)
+		require.Error(t, err)
+	})
+
+	// 30 dimensions don't divide into 4 subspaces evenly
+	pq, err := NewProductQuantizer(dim, 4, 64, distance.Euclidean)
+	require.NoError(t, err)
+	require.NoError(t, pq.Fit(vectors))
+
+	t.Run("decoded vectors approximate the encoded ones", func(t *testing.T) {
+		var quantizationErr, spread float64
+
+		for _, v := range vectors {
+			code := pq.Encode(v)
+			require.Len(t, code, 4)
+
+			decoded := pq.Decode(code)
+			require.Len(t, decoded, dim)
+
+			quantizationErr += math.Sqrt(float64(squaredEuclidean(v, decoded)))
+			spread += math.Sqrt(float64(squaredEuclidean(v, vectors[rand.Intn(size)])))
+		}
+
+		require.Less(t, quantizationErr, spread/2)
+	})
+
+	t.Run("distances", func(t *testing.T) {
+		for _, distanceType := range []string{distance.Euclidean, distance.DotProduct, distance.Cosine, distance.Manhattan} {
+			pq.distanceType = distanceType
+
+			q, v := vectors[0], vectors[1]
+			code := pq.Encode(v)
+			decoded := pq.Decode(code)
+
+			expected := pq.aggregate(pq.subDistance(q, decoded))
+			require.InDelta(t, expected, pq.NewDistanceTable(q).Distance(code), 1e-4, distanceType)
+			require.InDelta(t, expected, pq.VectorDistance(q, code), 1e-4, distanceType)
+
+			expected = pq.aggregate(pq.subDistance(pq.Decode(pq.Encode(q)), decoded))
+			require.InDelta(t, expected, pq.CodeDistance(pq.Encode(q), code), 1e-4, distanceType)
+		}
+
+		pq.distanceType = distance.Euclidean
+	})
+
+	t.Run("codebooks serialization", func(t *testing.T) {
+		b, err := pq.MarshalBinary()
+		require.NoError(t, err)
+
+		restored, err := UnmarshalProductQuantizer(b, distance.Euclidean)
+		require.NoError(t, err)
+		require.Equal(t, pq.codebooks, restored.codebooks)
+		require.Equal(t, pq.Encode(vectors[3]), restored.Encode(vectors[3]))
+
+		_, err = UnmarshalProductQuantizer(b[:len(b)-1], distance.Euclidean)
+		require.ErrorIs(t, err, ErrCorruptedCodebooks)
+	})
+}
@@ -0,0 +1,25 @@
+package quantization
+
+// Quantizer compresses vectors to codes, distances are calculated over the codes instead of the full vectors
+type Quantizer interface {
+	// Fit trains the quantizer on a sample of the vectors it will encode
+	Fit(vectors [][]float32) error
+
+	Encode(v []float32) []byte
+
+	// NewQueryDistancer returns the distancer of q from codes
+	NewQueryDistancer(q []float32) QueryDistancer
+
+	// CodeDistance returns the distance between the vectors encoded to a and b
+	CodeDistance(a, b []byte) float32
+
+	// VectorDistance returns the distance of v from the vector encoded to code
+	VectorDistance(v []float32, code []byte) float32
+
+	MarshalBinary() ([]byte, error)
+}
+
+// QueryDistancer calculates the distance of a single query from codes
+type QueryDistancer interface {
+	Distance(code []byte) float32
+}
@@ -0,0 +1,195 @@
+package quantization
+
+import (
+	"Vectory/db/core/index/distance"
+	distanceentities "Vectory/entities/distance"
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+var ErrCorruptedScalarQuantizer = errors.New("corrupted scalar quantizer")
+
+var _ Quantizer = &ScalarQuantizer{}
+
+// ScalarQuantizer encodes every dimension to a byte, x ≈ min[i] + scale*c. min is learnt per dimension and the
+// scale is shared by all dimensions so that distances between codes are calculated with integer kernels.
+// a code is followed by sum(min[i]*c[i]) as a float32, which is needed for calculating dot products.
+type ScalarQuantizer struct {
+	dim          int
+	distanceType string
+	min          []float32
+	scale        float32
+	minNorm      float32 // sum(min[i]*min[i])
+}
+
+func NewScalarQuantizer(dim int, distanceType string) *ScalarQuantizer {
+	return &ScalarQuantizer{
+		dim:          dim,
+		distanceType: distanceType,
+		min:          make([]float32, dim),
+		scale:        1,
+	}
+}
+
+// Fit learns the minimum and maximum of every dimension of vectors, the scale fits the widest dimension into a byte
+func (sq *ScalarQuantizer) Fit(vectors [][]float32) error {
+	if len(vectors) == 0 {
+		return errors.New("no vectors to train on")
+	}
+
+	max := make([]float32, sq.dim)
+	copy(sq.min, vectors[0])
+	copy(max, vectors[0])
+
+	for _, v := range vectors {
+		if len(v) != sq.dim {
+			return errors.New("training vector dimension doesn't match the quantizer dimension")
+		}
+
+		for i, x := range v {
+			if x < sq.min[i] {
+				sq.min[i] = x
+			}
+
+			if x > max[i] {
+				max[i] = x
+			}
+		}
+	}
+
+	var maxRange float32
+	for i := range max {
+		if r := max[i] - sq.min[i]; r > maxRange {
+			maxRange = r
+		}
+	}
+
+	sq.scale = 1
+	if maxRange > 0 {
+		sq.scale = maxRange / math.MaxUint8
+	}
+
+	sq.initMinNorm()
+
+	return nil
+}
+
+func (sq *ScalarQuantizer) initMinNorm() {
+	sq.minNorm = 0
+	for _, m := range sq.min {
+		sq.minNorm += m * m
+	}
+}
+
+// Encode returns the code of v, values out of the learnt range are clipped to it
+func (sq *ScalarQuantizer) Encode(v []float32) []byte {
+	code := make([]byte, sq.dim+4)
+
+	var minDot float32
+	for i, x := range v {
+		c := math.Round(float64((x - sq.min[i]) / sq.scale))
+		if c < 0 {
+			c = 0
+		} else if c > math.MaxUint8 {
+			c = math.MaxUint8
+		}
+
+		code[i] = byte(c)
+		minDot += sq.min[i] * float32(code[i])
+	}
+
+	binary.LittleEndian.PutUint32(code[sq.dim:], math.Float32bits(minDot))
+
+	return code
+}
+
+// Decode returns the approximation of the vector encoded to code
+func (sq *ScalarQuantizer) Decode(code []byte) []float32 {
+	v := make([]float32, sq.dim)
+	for i := range v {
+		v[i] = sq.min[i] + sq.scale*float32(code[i])
+	}
+
+	return v
+}
+
+func (sq *ScalarQuantizer) minDot(code []byte) float32 {
+	return math.Float32frombits(binary.LittleEndian.Uint32(code[sq.dim:]))
+}
+
+// CodeDistance returns the distance between the vectors encoded to a and b
+func (sq *ScalarQuantizer) CodeDistance(a, b []byte) float32 {
+	switch sq.distanceType {
+	case distanceentities.DotProduct:
+		return sq.dot(a, b)
+	case distanceentities.Cosine:
+		return 1 - sq.dot(a, b)
+	case distanceentities.Manhattan:
+		return sq.scale * float32(distance.ManhattanUint8(a[:sq.dim], b[:sq.dim]))
+	default:
+		return sq.scale * float32(math.Sqrt(float64(distance.SquaredEuclideanUint8(a[:sq.dim], b[:sq.dim]))))
+	}
+}
+
+// dot expands sum((min[i] + scale*a[i]) * (min[i] + scale*b[i]))
+func (sq *ScalarQuantizer) dot(a, b []byte) float32 {
+	return sq.minNorm + sq.scale*(sq.minDot(a)+sq.minDot(b)) +
+		sq.scale*sq.scale*float32(distance.DotUint8(a[:sq.dim], b[:sq.dim]))
+}
+
+// VectorDistance returns the distance of v from the vector encoded to code, v is encoded as well
+func (sq *ScalarQuantizer) VectorDistance(v []float32, code []byte) float32 {
+	return sq.CodeDistance(sq.Encode(v), code)
+}
+
+type scalarQueryDistancer struct {
+	sq   *ScalarQuantizer
+	code []byte
+}
+
+// NewQueryDistancer encodes q once, so its distance from codes is calculated with the integer kernels
+func (sq *ScalarQuantizer) NewQueryDistancer(q []float32) QueryDistancer {
+	return &scalarQueryDistancer{sq: sq, code: sq.Encode(q)}
+}
+
+func (d *scalarQueryDistancer) Distance(code []byte) float32 {
+	return d.sq.CodeDistance(d.code, code)
+}
+
+// MarshalBinary serializes the quantizer as [dim, scale, min...]
+func (sq *ScalarQuantizer) MarshalBinary() ([]byte, error) {
+	buff := make([]byte, 8+4*sq.dim)
+
+	binary.LittleEndian.PutUint32(buff[0:], uint32(sq.dim))
+	binary.LittleEndian.PutUint32(buff[4:], math.Float32bits(sq.scale))
+
+	for i, m := range sq.min {
+		binary.LittleEndian.PutUint32(buff[8+4*i:], math.Float32bits(m))
+	}
+
+	return buff, nil
+}
+
+// UnmarshalScalarQuantizer deserializes a quantizer serialized by MarshalBinary
+func UnmarshalScalarQuantizer(buff []byte, distanceType string) (*ScalarQuantizer, error) {
+	if len(buff) < 8 {
+		return nil, ErrCorruptedScalarQuantizer
+	}
+
+	dim := int(binary.LittleEndian.Uint32(buff[0:]))
+	if len(buff) != 8+4*dim {
+		return nil, ErrCorruptedScalarQuantizer
+	}
+
+	sq := NewScalarQuantizer(dim, distanceType)
+	sq.scale = math.Float32frombits(binary.LittleEndian.Uint32(buff[4:]))
+
+	for i := range sq.min {
+		sq.min[i] = math.Float32frombits(binary.LittleEndian.Uint32(buff[8+4*i:]))
+	}
+
+	sq.initMinNorm()
+
+	return sq, nil
+}
@@ -0,0 +1,78 @@
+package quantization
+
+import (
+	"Vectory/db/core/index/distance"
+	distanceentities "Vectory/entities/distance"
+	"github.com/stretchr/testify/require"
+	"math/rand"
+	"testing"
+)
+
+func TestScalarQuantizer(t *testing.T) {
+	size, dim := 1000, 30
+
+	vectors := make([][]float32, size)
+	for i := range vectors {
+		vectors[i] = make([]float32, dim)
+		for j := range vectors[i] {
+			vectors[i][j] = rand.Float32()*2 - 1
+		}
+	}
+
+	sq := NewScalarQuantizer(dim, distanceentities.Euclidean)
+	require.NoError(t, sq.Fit(vectors))
+
+	t.Run("decoded vectors approximate the encoded ones", func(t *testing.T) {
+		for _, v := range vectors {
+			code := sq.Encode(v)
+			require.Len(t, code, dim+4)
+
+			for i, x := range sq.Decode(code) {
+				require.InDelta(t, v[i], x, float64(sq.scale/2)+1e-6)
+			}
+		}
+	})
+
+	t.Run("out of range values are clipped", func(t *testing.T) {
+		v := make([]float32, dim)
+		v[0], v[1] = 100, -100
+
+		code := sq.Encode(v)
+		require.Equal(t, byte(255), code[0])
+		require.Equal(t, byte(0), code[1])
+	})
+
+	t.Run("distances", func(t *testing.T) {
+		distanceFuncs := map[string]func([]float32, []float32) float32{
+			distanceentities.Euclidean:  distance.EuclideanDistance,
+			distanceentities.DotProduct: distance.Dot,
+			distanceentities.Cosine:     distance.CosineDistance,
+			distanceentities.Manhattan:  distance.ManhattanDistance,
+		}
+
+		for distanceType, distanceFunc := range distanceFuncs {
+			sq.distanceType = distanceType
+
+			a, b := sq.Encode(vectors[0]), sq.Encode(vectors[1])
+			expected := distanceFunc(sq.Decode(a), sq.Decode(b))
+
+			require.InDelta(t, expected, sq.CodeDistance(a, b), 1e-4, distanceType)
+			require.InDelta(t, expected, sq.NewQueryDistancer(vectors[0]).Distance(b), 1e-4, distanceType)
+			require.InDelta(t, expected, sq.VectorDistance(vectors[0], b), 1e-4, distanceType)
+		}
+
+		sq.distanceType = distanceentities.Euclidean
+	})
+
+	t.Run("serialization", func(t *testing.T) {
+		b, err := sq.MarshalBinary()
+		require.NoError(t, err)
+
+		restored, err := UnmarshalScalarQuantizer(b, distanceentities.Euclidean)
+		require.NoError(t, err)
+		require.Equal(t, sq, restored)
+
+		_, err = UnmarshalScalarQuantizer(b[:len(b)-1], distanceentities.Euclidean)
+		require.ErrorIs(t, err, ErrCorruptedScalarQuantizer)
+	})
+}
@@ -0,0 +1,125 @@
+package utils
+
+import "math/bits"
+
+// Bitmap is a dense set of ids, suited for the sequential ids assigned to objects.
+type Bitmap struct {
+	words []uint64
+	size  int
+}
+
+// NewBitmap returns an empty bitmap.
+func NewBitmap() *Bitmap {
+	return &Bitmap{}
+}
+
+// Add adds id to the bitmap.
+func (b *Bitmap) Add(id uint64) {
+	w := int(id / 64)
+	if w >= len(b.words) {
+		words := make([]uint64, w+1, 2*(w+1))
+		copy(words, b.words)
+		b.words = words
+	}
+
+	mask := uint64(1) << (id % 64)
+	if b.words[w]&mask == 0 {
+		b.words[w] |= mask
+		b.size++
+	}
+}
+
+// Remove removes id from the bitmap.
+func (b *Bitmap) Remove(id uint64) {
+	w := int(id / 64)
+	if w >= len(b.words) {
+		return
+	}
+
+	mask := uint64(1) << (id % 64)
+	if b.words[w]&mask != 0 {
+		b.words[w] &^= mask
+		b.size--
+	}
+}
+
+// Contains returns true if id is in the bitmap.
+func (b *Bitmap) Contains(id uint64) bool {
+	w := int(id / 64)
+	if w >= len(b.words) {
+		return false
+	}
+
+	return b.words[w]&(uint64(1)<<(id%64)) != 0
+}
+
+// Len returns the number of ids in the bitmap.
+func (b *Bitmap) Len() int {
+	return b.size
+}
+
+// ToSlice returns the ids in the bitmap in ascending order.
+func (b *Bitmap) ToSlice() []uint64 {
+	ids := make([]uint64, 0, b.size)
+	for w, word := range b.words {
+		for i := uint64(0); word != 0; i++ {
+			if word&1 == 1 {
+				ids = append(ids, uint64(w)*64+i)
+			}
+
+			word >>= 1
+		}
+	}
+
+	return ids
+}
+
+// Clone returns a copy of the bitmap.
+func (b *Bitmap) Clone() *Bitmap {
+	return &Bitmap{words: append([]uint64(nil), b.words...), size: b.size}
+}
+
+// And keeps only the ids of the bitmap that are also in other.
+func (b *Bitmap) And(other *Bitmap) {
+	for w := range b.words {
+		if w < len(other.words) {
+			b.words[w] &= other.words[w]
+		} else {
+			b.words[w] = 0
+		}
+	}
+
+	b.count()
+}
+
+// Or adds the ids of other to the bitmap.
+func (b *Bitmap) Or(other *Bitmap) {
+	if len(other.words) > len(b.words) {
+		words := make([]uint64, len(other.words))
+		copy(words, b.words)
+		b.words = words
+	}
+
+	for w, word := range other.words {
+		b.words[w] |= word
+	}
+
+	b.count()
+}
+
+// AndNot removes the ids of other from the bitmap.
+func (b *Bitmap) AndNot(other *Bitmap) {
+	for w := 0; w < len(b.words) && w < len(other.words); w++ {
+		b.words[w] &^= other.words[w]
+	}
+
+	b.count()
+}
+
+// count recomputes the number of ids in the bitmap.
+func (b *Bitmap) count() {
+	b.size = 0
+	for _, word := range b.words {
+		b.size += bits.OnesCount64(word)
+	}
+}
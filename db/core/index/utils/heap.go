@@ -31,8 +31,9 @@ func (h *Heap) Pop() any {
 	return x
 }
 
+// Peek returns the top of the heap without removing it.
 func (h *Heap) Peek() any {
-	return h.Elements[h.Len()-1]
+	return h.Elements[0]
 }
 
 func NewMinHeap(capacity int) *Heap {
@@ -5,34 +5,122 @@ import (
 	"encoding/binary"
 	"errors"
 	"git.mills.io/prologic/bitcask"
+	"os"
+	"path/filepath"
+	"strings"
 )
 
+var errStopFold = errors.New("stop fold")
+
 const (
 	objectsDir = "object_storage"
 	vectorsDir = "vectors_storage"
+	keysDir    = "keys_storage"
+)
+
+// derivedFiles are the files of a bitcask store that are rebuilt from its data files when missing
+var derivedFiles = map[string]struct{}{"index": {}, "ttl_index": {}, "lock": {}}
+
+// IsDerivedFile reports whether path, relative to the stores' directory, is rebuilt when missing.
+// such files may be stale while the stores are open, so copies of the stores should leave them out.
+func IsDerivedFile(path string) bool {
+	dir, file := filepath.Split(filepath.ToSlash(path))
+
+	switch strings.TrimSuffix(dir, "/") {
+	case objectsDir, vectorsDir, keysDir:
+		_, ok := derivedFiles[file]
+		return ok
+	default:
+		return false
+	}
+}
+
+// prefixes of the two directions of the mapping between the keys and ids of objects in the keys store
+const (
+	keyToIdPrefix = 'k'
+	idToKeyPrefix = 'i'
 )
 
 type Stores struct {
 	// objects is a persistent storage for all objects in a collection
 	objects *bitcask.Bitcask
 
-	// vectors is a persistent storage for all vectors in a collection
+	// vectors is a persistent storage for all vectors in a collection, named vectors are keyed by id and name
 	vectors *bitcask.Bitcask
+
+	// keys is a persistent mapping between the caller-provided keys of objects and their ids
+	keys *bitcask.Bitcask
+
+	// vectorName is the named vector the vector methods access, the objects' vector when empty
+	vectorName string
+
+	filesPath string
 }
 
 func NewStores(filesPath string) (*Stores, error) {
-	objects, err := bitcask.Open(filesPath + "/" + objectsDir)
+	objects, err := openStore(filesPath + "/" + objectsDir)
 	if err != nil {
 		return nil, err
 	}
 
-	vectors, err := bitcask.Open(filesPath + "/" + vectorsDir)
+	vectors, err := openStore(filesPath + "/" + vectorsDir)
+	if err != nil {
+		return nil, err
+	}
 
-	s := Stores{objects: objects, vectors: vectors}
+	keys, err := openStore(filesPath+"/"+keysDir, bitcask.WithMaxKeySize(1+objstore.MaxKeyLength))
+	if err != nil {
+		return nil, err
+	}
+
+	s := Stores{objects: objects, vectors: vectors, keys: keys, filesPath: filesPath}
 
 	return &s, nil
 }
 
+// openStore opens the bitcask store in path and removes its index files, which are written again when it's closed.
+// bitcask trusts the index files of a store that was once closed even if it wasn't closed since, so a store that
+// wasn't closed must be reindexed from its data files instead.
+func openStore(path string, options ...bitcask.Option) (*bitcask.Bitcask, error) {
+	b, err := bitcask.Open(path, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = removeIndexFiles(path); err != nil {
+		b.Close()
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// removeIndexFiles removes the index files of the store in path.
+func removeIndexFiles(path string) error {
+	for _, file := range []string{"index", "ttl_index"} {
+		if err := os.Remove(filepath.Join(path, file)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Named returns a view of the stores whose vector methods access the named vector name instead of the objects' vector.
+// it shares the underlying storage and should not be closed.
+func (s *Stores) Named(name string) *Stores {
+	return &Stores{objects: s.objects, vectors: s.vectors, keys: s.keys, vectorName: name, filesPath: s.filesPath}
+}
+
+// vectorKey returns the key of the vector of id in the vectors store
+func vectorKey(id uint64, name string) []byte {
+	key := make([]byte, 8+len(name))
+	binary.LittleEndian.PutUint64(key, id)
+	copy(key[8:], name)
+
+	return key
+}
+
 func (s *Stores) PutObject(obj *objstore.Object) error {
 	idBytes := make([]byte, 8) // TODO: can be reused
 	binary.LittleEndian.PutUint64(idBytes, obj.Id)
@@ -52,6 +140,27 @@ func (s *Stores) PutObject(obj *objstore.Object) error {
 		return err
 	}
 
+	if obj.Key != "" {
+		if err = s.keys.Put(append([]byte{keyToIdPrefix}, obj.Key...), idBytes); err != nil {
+			return err
+		}
+
+		if err = s.keys.Put(append([]byte{idToKeyPrefix}, idBytes...), []byte(obj.Key)); err != nil {
+			return err
+		}
+	}
+
+	for name, vector := range obj.Vectors {
+		b, err := (&objstore.Object{Vector: vector}).SerializeVector()
+		if err != nil {
+			return err
+		}
+
+		if err = s.vectors.Put(vectorKey(obj.Id, name), b); err != nil {
+			return err
+		}
+	}
+
 	return s.vectors.Put(idBytes, vecBytes)
 }
 
@@ -90,9 +199,52 @@ func (s *Stores) GetObject(id uint64) (*objstore.Object, bool, error) {
 
 	obj.Id = id
 
+	if obj.Key, err = s.getKey(idBytes); err != nil {
+		return nil, false, err
+	}
+
+	if obj.Vectors, err = s.getNamedVectors(idBytes); err != nil {
+		return nil, false, err
+	}
+
 	return &obj, true, nil
 }
 
+// getNamedVectors returns the named vectors of the object with idBytes, nil when it has none
+func (s *Stores) getNamedVectors(idBytes []byte) (map[string][]float32, error) {
+	var names []string
+
+	// keys are collected before reading them since the store can't be read while it is scanned
+	err := s.vectors.Scan(idBytes, func(key []byte) error {
+		if len(key) > len(idBytes) {
+			names = append(names, string(key[len(idBytes):]))
+		}
+
+		return nil
+	})
+	if err != nil || len(names) == 0 {
+		return nil, err
+	}
+
+	vectors := make(map[string][]float32, len(names))
+
+	for _, name := range names {
+		b, err := s.vectors.Get(vectorKey(binary.LittleEndian.Uint64(idBytes), name))
+		if err != nil {
+			return nil, err
+		}
+
+		var v objstore.Object
+		if err = v.DeserializeVector(b); err != nil {
+			return nil, err
+		}
+
+		vectors[name] = v.Vector
+	}
+
+	return vectors, nil
+}
+
 func (s *Stores) GetObjects(ids []uint64) ([]*objstore.Object, error) {
 	objects := make([]*objstore.Object, 0, len(ids))
 	for _, id := range ids {
@@ -115,15 +267,55 @@ func (s *Stores) DeleteObject(id uint64) error {
 	idBytes := make([]byte, 8) // TODO: can be reused
 	binary.LittleEndian.PutUint64(idBytes, id)
 
+	key, err := s.getKey(idBytes)
+	if err != nil {
+		return err
+	}
+
+	if key != "" {
+		if err = s.keys.Delete(append([]byte{keyToIdPrefix}, key...)); err != nil {
+			return err
+		}
+
+		if err = s.keys.Delete(append([]byte{idToKeyPrefix}, idBytes...)); err != nil {
+			return err
+		}
+	}
+
 	// TODO: currently delete only the actual object but keep its vector in the vectors store for index recovery and traversal
 	return s.objects.Delete(idBytes)
 }
 
-func (s *Stores) GetVector(id uint64) ([]float32, bool, error) {
-	idBytes := make([]byte, 8) // TODO: can be reused
-	binary.LittleEndian.PutUint64(idBytes, id)
+// GetId returns the id of the object with key.
+func (s *Stores) GetId(key string) (uint64, bool, error) {
+	idBytes, err := s.keys.Get(append([]byte{keyToIdPrefix}, key...))
+	if err != nil {
+		if errors.Is(err, bitcask.ErrKeyNotFound) {
+			return 0, false, nil
+		}
 
-	vector, err := s.vectors.Get(idBytes)
+		return 0, false, err
+	}
+
+	return binary.LittleEndian.Uint64(idBytes), true, nil
+}
+
+// getKey returns the key of the object with idBytes, empty when it has none
+func (s *Stores) getKey(idBytes []byte) (string, error) {
+	key, err := s.keys.Get(append([]byte{idToKeyPrefix}, idBytes...))
+	if err != nil {
+		if errors.Is(err, bitcask.ErrKeyNotFound) {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	return string(key), nil
+}
+
+func (s *Stores) GetVector(id uint64) ([]float32, bool, error) {
+	vector, err := s.vectors.Get(vectorKey(id, s.vectorName))
 	if err != nil {
 		if errors.Is(err, bitcask.ErrKeyNotFound) {
 			return nil, false, nil
@@ -141,9 +333,73 @@ func (s *Stores) GetVector(id uint64) ([]float32, bool, error) {
 	return obj.Vector, true, nil
 }
 
-// TODO: we can do better
-func (s *Stores) GetVectorsStore() *bitcask.Bitcask {
-	return s.vectors
+// VectorsIds returns the ids of all vectors in the store, including the ones of deleted objects
+func (s *Stores) VectorsIds() ([]uint64, error) {
+	ids := make([]uint64, 0, s.vectors.Len())
+
+	err := s.vectors.Fold(func(key []byte) error {
+		if len(key) == 8+len(s.vectorName) && string(key[8:]) == s.vectorName {
+			ids = append(ids, binary.LittleEndian.Uint64(key))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// ObjectsIds returns the ids of up to limit objects in the store, or all of them if limit is not positive.
+func (s *Stores) ObjectsIds(limit int) ([]uint64, error) {
+	size := s.objects.Len()
+	if limit > 0 && limit < size {
+		size = limit
+	}
+
+	ids := make([]uint64, 0, size)
+
+	err := s.objects.Fold(func(key []byte) error {
+		if limit > 0 && len(ids) == limit {
+			return errStopFold
+		}
+
+		ids = append(ids, binary.LittleEndian.Uint64(key))
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, errStopFold) {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// Compact deletes the vectors of deleted objects, which must no longer be traversed by the indexes, and merges the
+// data files of the stores to reclaim the space of deleted and overwritten values.
+func (s *Stores) Compact() error {
+	err := s.vectors.Sift(func(key []byte) (bool, error) {
+		return !s.objects.Has(key[:8]), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	stores := map[string]*bitcask.Bitcask{objectsDir: s.objects, vectorsDir: s.vectors, keysDir: s.keys}
+
+	for dir, store := range stores {
+		if err = store.Merge(); err != nil {
+			return err
+		}
+
+		// the merge writes the index files of the merged store
+		if err = removeIndexFiles(s.filesPath + "/" + dir); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (s *Stores) Size() int {
@@ -159,5 +415,9 @@ func (s *Stores) Close() error {
 		return err
 	}
 
+	if err := s.keys.Close(); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -2,7 +2,9 @@ package db
 
 import (
 	"Vectory/entities/collection"
+	"Vectory/entities/filters"
 	"Vectory/entities/objstore"
+	"Vectory/entities/search"
 	"context"
 )
 
@@ -10,8 +12,13 @@ type CRUD interface {
 	Insert(ctx context.Context, obj *objstore.Object) error
 	InsertBatch(ctx context.Context, objs []*objstore.Object) error
 	InsertBatch2(ctx context.Context, objs []*objstore.Object) error
-	Update(obj *objstore.Object) error
+	InsertBatchWithResults(ctx context.Context, objs []*objstore.Object) (*collection.BatchResult, error)
+	Update(ctx context.Context, obj *objstore.Object) error
+	Upsert(ctx context.Context, obj *objstore.Object) error
 	Delete(objId uint64) error
+	DeleteByKey(key string) error
 	Get(objIds []uint64) ([]objstore.Object, error)
-	SemanticSearch(ctx context.Context, obj *objstore.Object, k int) (*collection.SemanticSearchResult, error)
+	GetByKeys(keys []string) ([]objstore.Object, error)
+	SemanticSearch(ctx context.Context, obj *objstore.Object, opts search.Options, filter *filters.Filter, hybrid *collection.HybridParams) (*collection.SemanticSearchResult, error)
+	RangeSearch(ctx context.Context, obj *objstore.Object, radius float32, limit int, vector string) (*collection.SemanticSearchResult, error)
 }
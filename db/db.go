@@ -77,7 +77,7 @@ func (db *DB) DeleteCollection(ctx context.Context, name string) error {
 	}
 
 	if _, ok := db.collections[name]; !ok {
-		return fmt.Errorf("%w: %s", ErrValidationFailed, ErrCollectionDoesntExist)
+		return validationError{err: ErrCollectionDoesntExist}
 	}
 
 	err := db.metadataManager.DeleteCollection(ctx, name)
@@ -101,7 +101,7 @@ func (db *DB) GetCollection(_ context.Context, name string) (*Collection, error)
 
 	c, ok := db.collections[name]
 	if !ok {
-		return nil, fmt.Errorf("%w: %s", ErrValidationFailed, ErrCollectionDoesntExist)
+		return nil, validationError{err: ErrCollectionDoesntExist}
 	}
 
 	return c, nil
@@ -152,15 +152,7 @@ func (db *DB) init() error {
 	}
 
 	for _, col := range cols {
-		c, err := newCollection(col.ID, &collection.Collection{
-			Name:           col.Name,
-			IndexType:      col.IndexType,
-			EmbedderType:   col.EmbedderType,
-			IndexParams:    col.IndexParams,
-			EmbedderConfig: col.EmbedderConfig,
-			DataType:       col.DataType,
-		}, db.filesPath)
-
+		c, err := newCollection(col.ID, metadata.Config(col), db.filesPath)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,47 @@
+package db
+
+import (
+	"Vectory/entities/collection"
+	"Vectory/entities/index"
+	"Vectory/entities/mappings"
+	"context"
+	"github.com/stretchr/testify/require"
+	"os"
+	"testing"
+)
+
+func TestDB_Reopen(t *testing.T) {
+	ctx := context.Background()
+	filesPath := "./tmp_reopen"
+	defer os.RemoveAll(filesPath)
+
+	cfg := collection.Collection{
+		Name:        "test_collection",
+		IndexType:   index.Hnsw,
+		DataType:    "text",
+		IndexParams: index.DefaultHnswParams,
+		Mappings: []mappings.Mapping{
+			{Name: "title", Type: mappings.Text, Indexed: true, Embed: true},
+			{Name: "year", Type: mappings.Int, Filterable: true},
+		},
+	}
+
+	db, err := Open(filesPath)
+	require.NoError(t, err)
+
+	_, err = db.CreateCollection(ctx, &cfg)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	db, err = Open(filesPath)
+	require.NoError(t, err)
+	defer db.Close()
+
+	c, err := db.GetCollection(ctx, cfg.Name)
+	require.NoError(t, err)
+
+	restored, err := c.GetConfig()
+	require.NoError(t, err)
+	require.Equal(t, cfg.Mappings, restored.Mappings)
+	require.Equal(t, collection.FloatVectorType, restored.VectorType)
+}
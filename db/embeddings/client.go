@@ -0,0 +1,246 @@
+package embeddings
+
+import (
+	embeddingsentities "Vectory/entities/embeddings"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	minBackoff = 500 * time.Millisecond
+	maxBackoff = 30 * time.Second
+
+	// maxErrorBodySize is the size of the provider's error body included in errors
+	maxErrorBodySize = 1024
+)
+
+// newRequestFunc creates the request of a batch of inputs
+type newRequestFunc func(ctx context.Context, inputs []string) (*http.Request, error)
+
+// decodeFunc decodes the vectors of a successful response, in the order of the inputs of its request
+type decodeFunc func(body io.Reader) ([][]float32, error)
+
+// client sends the requests of the HTTP embedders. inputs are split into batches sent concurrently, and requests
+// that failed with a 429 or 5xx status or a network error are retried with exponential backoff.
+type client struct {
+	http       *http.Client
+	config     embeddingsentities.ClientConfig
+	sem        chan struct{} // bounds the requests sent at the same time by all the calls of the embedder
+	minBackoff time.Duration
+	maxBackoff time.Duration
+}
+
+func newClient(cfg embeddingsentities.ClientConfig) *client {
+	cfg = cfg.WithDefaults()
+
+	return &client{
+		http:       http.DefaultClient,
+		config:     cfg,
+		sem:        make(chan struct{}, cfg.MaxConcurrency),
+		minBackoff: minBackoff,
+		maxBackoff: maxBackoff,
+	}
+}
+
+// embed returns the vectors of inputs. all vectors must have the same dimension, which is dim unless it's 0.
+func (c *client) embed(ctx context.Context, inputs []string, dim int, newRequest newRequestFunc, decode decodeFunc) ([][]float32, error) {
+	vectors := make([][]float32, len(inputs))
+	if len(inputs) == 0 {
+		return vectors, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg      sync.WaitGroup
+		errOnce sync.Once
+		err     error
+	)
+
+	for start := 0; start < len(inputs); start += c.config.MaxBatchSize {
+		end := start + c.config.MaxBatchSize
+		if end > len(inputs) {
+			end = len(inputs)
+		}
+
+		select {
+		case c.sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			defer func() { <-c.sem }()
+
+			batch, batchErr := c.send(ctx, inputs[start:end], newRequest, decode)
+			if batchErr != nil {
+				errOnce.Do(func() {
+					err = batchErr
+					cancel()
+				})
+
+				return
+			}
+
+			copy(vectors[start:end], batch)
+		}(start, end)
+	}
+
+	wg.Wait()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	if dim == 0 {
+		dim = len(vectors[0])
+	}
+
+	for i, v := range vectors {
+		if len(v) == 0 || len(v) != dim {
+			return nil, fmt.Errorf("failed creating embeddings: embedding %d has dimension %d instead of %d", i, len(v), dim)
+		}
+	}
+
+	return vectors, nil
+}
+
+// send sends the request of a batch of inputs, and retries it while it fails with a retryable error.
+func (c *client) send(ctx context.Context, inputs []string, newRequest newRequestFunc, decode decodeFunc) ([][]float32, error) {
+	for attempt := 0; ; attempt++ {
+		vectors, wait, err := c.attempt(ctx, inputs, newRequest, decode)
+		if err == nil {
+			return vectors, nil
+		}
+
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		var retryable *retryableError
+		if !errors.As(err, &retryable) || attempt >= *c.config.MaxRetries {
+			return nil, err
+		}
+
+		if wait <= 0 {
+			wait = c.backoff(attempt)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// attempt sends a single request, it returns the time the provider asked to wait before retrying it if any.
+func (c *client) attempt(ctx context.Context, inputs []string, newRequest newRequestFunc, decode decodeFunc) ([][]float32, time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(c.config.TimeoutSeconds)*time.Second)
+	defer cancel()
+
+	req, err := newRequest(ctx, inputs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		return nil, 0, &retryableError{err: err}
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(res.Body, maxErrorBodySize))
+		err = fmt.Errorf("failed creating embeddings: %s: %s", res.Status, strings.TrimSpace(string(body)))
+
+		if res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= http.StatusInternalServerError {
+			return nil, c.retryAfter(res.Header.Get("Retry-After")), &retryableError{err: err}
+		}
+
+		return nil, 0, err
+	}
+
+	vectors, err := decode(res.Body)
+	if err != nil {
+		err = fmt.Errorf("failed decoding embeddings: %w", err)
+
+		// the response was cut by the timeout
+		if ctx.Err() != nil {
+			return nil, 0, &retryableError{err: err}
+		}
+
+		return nil, 0, err
+	}
+
+	if len(vectors) != len(inputs) {
+		return nil, 0, fmt.Errorf("failed creating embeddings: got %d embeddings for %d inputs", len(vectors), len(inputs))
+	}
+
+	return vectors, 0, nil
+}
+
+// backoff returns the time to wait before retrying a request for the attempt+1 time, it doubles with each
+// attempt up to maxBackoff and is jittered so that concurrent requests aren't retried together.
+func (c *client) backoff(attempt int) time.Duration {
+	wait := c.maxBackoff
+	if attempt < 32 && c.minBackoff<<attempt < c.maxBackoff {
+		wait = c.minBackoff << attempt
+	}
+
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+}
+
+// retryAfter parses the value of a Retry-After header, either seconds or an HTTP date, up to maxBackoff.
+// it returns 0 when there's no value or it's in the past, and the request is retried with the backoff.
+func (c *client) retryAfter(value string) time.Duration {
+	var wait time.Duration
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		wait = time.Duration(seconds) * time.Second
+	} else if t, err := http.ParseTime(value); err == nil {
+		wait = time.Until(t)
+	}
+
+	switch {
+	case wait < 0:
+		return 0
+	case wait > c.maxBackoff:
+		return c.maxBackoff
+	default:
+		return wait
+	}
+}
+
+// retryableError is an error of a request that may succeed if it's retried
+type retryableError struct {
+	err error
+}
+
+func (e *retryableError) Error() string {
+	return e.err.Error()
+}
+
+func (e *retryableError) Unwrap() error {
+	return e.err
+}
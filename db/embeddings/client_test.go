@@ -0,0 +1,198 @@
+package embeddings
+
+import (
+	"Vectory/entities/embeddings/openai"
+	"Vectory/entities/objstore"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClient_Embed(t *testing.T) {
+	// respond is the behaviour of the stub server for the n-th request, counted from 1
+	var (
+		requests, inFlight, maxInFlight atomic.Int32
+		respond                         func(n int32, w http.ResponseWriter, req openai.EmbeddingRequest)
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := requests.Add(1)
+
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+
+		for {
+			prev := maxInFlight.Load()
+			if current <= prev || maxInFlight.CompareAndSwap(prev, current) {
+				break
+			}
+		}
+
+		var req openai.EmbeddingRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		respond(n, w, req)
+	}))
+	defer server.Close()
+
+	ok := func(w http.ResponseWriter, req openai.EmbeddingRequest) {
+		res := openai.EmbeddingResponse{}
+		for i, in := range req.Input {
+			var v float32
+			_, _ = fmt.Sscanf(in, "text:%f", &v)
+			res.Data = append(res.Data, openai.Embedding{Index: i, Embedding: []float32{v, 1}})
+		}
+
+		_ = json.NewEncoder(w).Encode(res)
+	}
+
+	newEmbedder := func(t *testing.T, cfg map[string]interface{}) *OpenAIEmbedder {
+		// requests abandoned by the previous test are still served
+		require.Eventually(t, func() bool { return inFlight.Load() == 0 }, 5*time.Second, 10*time.Millisecond)
+
+		requests.Store(0)
+		maxInFlight.Store(0)
+
+		cfg["base_url"] = server.URL
+		cfg["model"] = "m"
+
+		e, err := New(openai.OpenAI, cfg)
+		require.NoError(t, err)
+
+		embedder := e.(*OpenAIEmbedder)
+		embedder.client.minBackoff = time.Millisecond
+		embedder.client.maxBackoff = 10 * time.Millisecond
+
+		return embedder
+	}
+
+	newObjects := func(n int) []*objstore.Object {
+		objects := make([]*objstore.Object, n)
+		for i := range objects {
+			objects[i] = &objstore.Object{Properties: map[string]interface{}{"text": i}}
+		}
+
+		return objects
+	}
+
+	t.Run("batches with bounded concurrency", func(t *testing.T) {
+		e := newEmbedder(t, map[string]interface{}{"max_batch_size": 2, "max_concurrency": 2})
+		respond = func(_ int32, w http.ResponseWriter, req openai.EmbeddingRequest) {
+			require.LessOrEqual(t, len(req.Input), 2)
+			time.Sleep(20 * time.Millisecond)
+			ok(w, req)
+		}
+
+		objects := newObjects(7)
+		require.NoError(t, e.Embed(context.Background(), objects))
+		require.Equal(t, int32(4), requests.Load())
+		require.LessOrEqual(t, maxInFlight.Load(), int32(2))
+
+		for i, o := range objects {
+			require.Equal(t, []float32{float32(i), 1}, o.Vector)
+		}
+	})
+
+	t.Run("retries 429 and 5xx", func(t *testing.T) {
+		e := newEmbedder(t, map[string]interface{}{})
+		e.client.maxBackoff = 2 * time.Second
+		respond = func(n int32, w http.ResponseWriter, req openai.EmbeddingRequest) {
+			switch n {
+			case 1:
+				w.Header().Set("Retry-After", "1")
+				w.WriteHeader(http.StatusTooManyRequests)
+			case 2:
+				w.WriteHeader(http.StatusBadGateway)
+			default:
+				ok(w, req)
+			}
+		}
+
+		start := time.Now()
+		require.NoError(t, e.Embed(context.Background(), newObjects(3)))
+		require.Equal(t, int32(3), requests.Load())
+		require.GreaterOrEqual(t, time.Since(start), time.Second)
+	})
+
+	t.Run("retry-after is capped", func(t *testing.T) {
+		e := newEmbedder(t, map[string]interface{}{})
+		respond = func(n int32, w http.ResponseWriter, req openai.EmbeddingRequest) {
+			switch n {
+			case 1:
+				w.Header().Set("Retry-After", "3600")
+				w.WriteHeader(http.StatusTooManyRequests)
+			case 2:
+				w.Header().Set("Retry-After", time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat))
+				w.WriteHeader(http.StatusTooManyRequests)
+			default:
+				ok(w, req)
+			}
+		}
+
+		start := time.Now()
+		require.NoError(t, e.Embed(context.Background(), newObjects(3)))
+		require.Equal(t, int32(3), requests.Load())
+		require.Less(t, time.Since(start), time.Second)
+
+		require.Equal(t, e.client.maxBackoff, e.client.retryAfter(time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)))
+		require.Equal(t, time.Duration(0), e.client.retryAfter("-5"))
+		require.Equal(t, time.Duration(0), e.client.retryAfter("soon"))
+	})
+
+	t.Run("retries are exhausted", func(t *testing.T) {
+		e := newEmbedder(t, map[string]interface{}{"max_retries": 2})
+		respond = func(_ int32, w http.ResponseWriter, _ openai.EmbeddingRequest) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"error":"overloaded"}`))
+		}
+
+		err := e.Embed(context.Background(), newObjects(3))
+		require.ErrorContains(t, err, "503")
+		require.ErrorContains(t, err, "overloaded")
+		require.Equal(t, int32(3), requests.Load())
+	})
+
+	t.Run("client errors aren't retried", func(t *testing.T) {
+		e := newEmbedder(t, map[string]interface{}{})
+		respond = func(_ int32, w http.ResponseWriter, _ openai.EmbeddingRequest) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"input too long"}`))
+		}
+
+		require.ErrorContains(t, e.Embed(context.Background(), newObjects(3)), "input too long")
+		require.Equal(t, int32(1), requests.Load())
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		e := newEmbedder(t, map[string]interface{}{"timeout_seconds": 1, "max_retries": 0})
+		respond = func(_ int32, w http.ResponseWriter, req openai.EmbeddingRequest) {
+			time.Sleep(1500 * time.Millisecond)
+			ok(w, req)
+		}
+
+		require.ErrorIs(t, e.Embed(context.Background(), newObjects(1)), context.DeadlineExceeded)
+	})
+
+	t.Run("mismatching embeddings", func(t *testing.T) {
+		e := newEmbedder(t, map[string]interface{}{"max_retries": 0})
+		respond = func(_ int32, w http.ResponseWriter, req openai.EmbeddingRequest) {
+			req.Input = req.Input[1:]
+			ok(w, req)
+		}
+
+		require.ErrorContains(t, e.Embed(context.Background(), newObjects(3)), "got 2 embeddings for 3 inputs")
+
+		e = newEmbedder(t, map[string]interface{}{"dimensions": 3})
+		respond = func(_ int32, w http.ResponseWriter, req openai.EmbeddingRequest) {
+			ok(w, req)
+		}
+
+		require.ErrorContains(t, e.Embed(context.Background(), newObjects(3)), "dimension 2 instead of 3")
+	})
+}
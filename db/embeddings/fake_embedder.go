@@ -12,6 +12,12 @@ const FakeEmbedder = "fake_embedder"
 type fake struct {
 }
 
+func init() {
+	Register(FakeEmbedder, nil, func(*struct{}) (Embedder, error) {
+		return NewFakeEmbedder(), nil
+	})
+}
+
 func NewFakeEmbedder() *fake {
 	return &fake{}
 }
@@ -0,0 +1,104 @@
+package embeddings
+
+import (
+	"Vectory/entities/embeddings/openai"
+	"Vectory/entities/objstore"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+func init() {
+	Register(openai.OpenAI, openai.ValidateConfig, func(cfg *openai.Config) (Embedder, error) {
+		return NewOpenAIEmbedder(cfg), nil
+	})
+}
+
+// OpenAIEmbedder creates embeddings with the OpenAI embeddings protocol.
+type OpenAIEmbedder struct {
+	client *client
+	config *openai.Config
+}
+
+func NewOpenAIEmbedder(cfg *openai.Config) *OpenAIEmbedder {
+	return &OpenAIEmbedder{
+		client: newClient(cfg.ClientConfig),
+		config: cfg,
+	}
+}
+
+func (e *OpenAIEmbedder) Embed(ctx context.Context, objects []*objstore.Object) error {
+	inputs := make([]string, 0, len(objects))
+	for _, o := range objects {
+		inputs = append(inputs, o.FlatProperties())
+	}
+
+	vectors, err := e.client.embed(ctx, inputs, e.config.Dimensions, e.newRequest, e.decode)
+	if err != nil {
+		return err
+	}
+
+	for i, o := range objects {
+		o.Vector = vectors[i]
+	}
+
+	return nil
+}
+
+func (e *OpenAIEmbedder) newRequest(ctx context.Context, inputs []string) (*http.Request, error) {
+	b, err := json.Marshal(openai.EmbeddingRequest{
+		Input:          inputs,
+		Model:          e.config.Model,
+		Dimensions:     e.config.Dimensions,
+		EncodingFormat: "float",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.GetURL(), bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	if e.config.ApiKey != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", e.config.ApiKey))
+	}
+
+	return req, nil
+}
+
+// decode places the embeddings of the response by their indexes, which aren't necessarily ordered
+func (e *OpenAIEmbedder) decode(body io.Reader) ([][]float32, error) {
+	var er openai.EmbeddingResponse
+	if err := json.NewDecoder(body).Decode(&er); err != nil {
+		return nil, err
+	}
+
+	vectors := make([][]float32, len(er.Data))
+	for _, d := range er.Data {
+		if d.Index < 0 || d.Index >= len(vectors) || vectors[d.Index] != nil {
+			return nil, fmt.Errorf("embedding index %d is out of range or duplicate", d.Index)
+		}
+
+		vectors[d.Index] = d.Embedding
+	}
+
+	return vectors, nil
+}
+
+// GetURL returns the URL of the embeddings endpoint.
+func (e *OpenAIEmbedder) GetURL() string {
+	baseURL := e.config.BaseURL
+	if baseURL == "" {
+		baseURL = openai.DefaultBaseURL
+	}
+
+	return strings.TrimSuffix(baseURL, "/") + "/embeddings"
+}
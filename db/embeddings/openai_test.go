@@ -0,0 +1,80 @@
+package embeddings
+
+import (
+	"Vectory/entities/embeddings/openai"
+	"Vectory/entities/objstore"
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestOpenAIEmbedder_Embed(t *testing.T) {
+	var got openai.EmbeddingRequest
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/embeddings", r.URL.Path)
+
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":{"message":"invalid api key"}}`))
+			return
+		}
+
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+
+		// embeddings are returned out of order, they're placed by their index
+		res := openai.EmbeddingResponse{}
+		for i := len(got.Input) - 1; i >= 0; i-- {
+			res.Data = append(res.Data, openai.Embedding{Index: i, Embedding: []float32{float32(i), float32(len(got.Input[i]))}})
+		}
+
+		require.NoError(t, json.NewEncoder(w).Encode(res))
+	}))
+	defer server.Close()
+
+	e, err := New(openai.OpenAI, map[string]interface{}{
+		"base_url":   server.URL + "/v1/",
+		"model":      "text-embedding-3-small",
+		"dimensions": 2,
+		"api_key":    "secret",
+	})
+	require.NoError(t, err)
+
+	objects := []*objstore.Object{
+		{Properties: map[string]interface{}{"text": "a"}},
+		{Properties: map[string]interface{}{"text": "bb"}},
+		{Properties: map[string]interface{}{"text": "ccc"}},
+	}
+
+	require.NoError(t, e.Embed(context.Background(), objects))
+	require.Equal(t, "text-embedding-3-small", got.Model)
+	require.Equal(t, 2, got.Dimensions)
+	require.Equal(t, "float", got.EncodingFormat)
+	require.Len(t, got.Input, len(objects))
+
+	for i, o := range objects {
+		require.Equal(t, got.Input[i], o.FlatProperties())
+		require.Equal(t, []float32{float32(i), float32(len(got.Input[i]))}, o.Vector)
+	}
+
+	t.Run("error status", func(t *testing.T) {
+		e, err := New(openai.OpenAI, openai.Config{BaseURL: server.URL + "/v1", Model: "text-embedding-3-small"})
+		require.NoError(t, err)
+
+		err = e.Embed(context.Background(), objects[:1])
+		require.ErrorContains(t, err, "401")
+		require.ErrorContains(t, err, "invalid api key")
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		require.Error(t, ValidateConfig(openai.OpenAI, map[string]interface{}{}))
+		require.Error(t, ValidateConfig(openai.OpenAI, map[string]interface{}{"model": "m", "base_url": "localhost"}))
+		require.ErrorContains(t, ValidateConfig(openai.OpenAI, map[string]interface{}{"model": "m", "modle": "m"}), "unknown field")
+
+		_, err := New("unknown", nil)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,144 @@
+package embeddings
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// registration of an embedder type, its functions take the type's decoded config
+type registration struct {
+	decode      func(cfg interface{}) (interface{}, error)
+	validate    func(cfg interface{}) error
+	newEmbedder func(cfg interface{}) (Embedder, error)
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[string]registration)
+)
+
+// Register makes the embedder type available to collections. the embedder_config of its collections is decoded
+// to C, validated by validate when it's not nil, and newEmbedder creates the collection's embedder from it.
+// registering the same type twice panics.
+func Register[C any](embedderType string, validate func(*C) error, newEmbedder func(*C) (Embedder, error)) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if _, ok := registry[embedderType]; ok {
+		panic(fmt.Sprintf("embedder %s is already registered", embedderType))
+	}
+
+	registry[embedderType] = registration{
+		decode: func(cfg interface{}) (interface{}, error) {
+			return decodeConfig[C](cfg)
+		},
+		validate: func(cfg interface{}) error {
+			if validate == nil {
+				return nil
+			}
+
+			return validate(cfg.(*C))
+		},
+		newEmbedder: func(cfg interface{}) (Embedder, error) {
+			return newEmbedder(cfg.(*C))
+		},
+	}
+}
+
+// decodeConfig decodes cfg, either a C or its JSON object as stored in the collection's metadata, to a C.
+// unknown fields are rejected so that misspelled options aren't silently ignored.
+func decodeConfig[C any](cfg interface{}) (*C, error) {
+	var config C
+
+	if cfg == nil {
+		return &config, nil
+	}
+
+	b, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+
+	if err = dec.Decode(&config); err != nil {
+		return nil, fmt.Errorf("invalid embedder_config: %w", err)
+	}
+
+	return &config, nil
+}
+
+// IsRegistered reports whether the embedder type was registered.
+func IsRegistered(embedderType string) bool {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	_, ok := registry[embedderType]
+
+	return ok
+}
+
+// Types returns the registered embedder types in sorted order.
+func Types() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	types := make([]string, 0, len(registry))
+	for t := range registry {
+		types = append(types, t)
+	}
+
+	sort.Strings(types)
+
+	return types
+}
+
+// ValidateConfig validates cfg as the config of the registered embedder type.
+func ValidateConfig(embedderType string, cfg interface{}) error {
+	r, err := lookup(embedderType)
+	if err != nil {
+		return err
+	}
+
+	config, err := r.decode(cfg)
+	if err != nil {
+		return err
+	}
+
+	return r.validate(config)
+}
+
+// New creates an embedder of the registered type from its config.
+func New(embedderType string, cfg interface{}) (Embedder, error) {
+	r, err := lookup(embedderType)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := r.decode(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.validate(config); err != nil {
+		return nil, err
+	}
+
+	return r.newEmbedder(config)
+}
+
+func lookup(embedderType string) (registration, error) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	r, ok := registry[embedderType]
+	if !ok {
+		return r, fmt.Errorf("embedder %s isn't registered", embedderType)
+	}
+
+	return r, nil
+}
@@ -8,114 +8,75 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 )
 
 type Text2vecEmbedder struct {
-	client *http.Client
+	client *client
 	config *text2vec.Config
 }
 
+func init() {
+	Register(text2vec.Text2VecHuggingFace, text2vec.ValidateConfig, func(cfg *text2vec.Config) (Embedder, error) {
+		return NewText2vecEmbedder(cfg), nil
+	})
+}
+
 func NewText2vecEmbedder(cfg *text2vec.Config) *Text2vecEmbedder {
 	e := Text2vecEmbedder{
-		client: http.DefaultClient,
+		client: newClient(cfg.ClientConfig),
 		config: cfg,
 	}
 
 	return &e
 }
 
-//func (e *text2vecEmbedder) Embed(ctx context.Context, objects []*objstore.Object) error {
-//	ch := make(chan []float32, len(objects))
-//	var wg sync.WaitGroup
-//	for _, o := range objects {
-//		input := o.FlatProperties()
-//		body := text2vec.EmbeddingRequest{
-//			Inputs: []string{input},
-//		}
-//
-//		b, err := json.Marshal(body)
-//		if err != nil {
-//			return err
-//		}
-//
-//		req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.GetURL(), bytes.NewReader(b))
-//		if err != nil {
-//			return err
-//		}
-//
-//		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", e.config.ApiKey))
-//
-//		wg.Add(1)
-//		go func(req *http.Request) {
-//			defer wg.Done()
-//
-//			res, err := e.client.Do(req)
-//			if err != nil {
-//				fmt.Printf("failed create embeddings")
-//			}
-//
-//			if res.StatusCode != http.StatusOK {
-//				fmt.Printf("failed create embeddings")
-//			}
-//
-//			er := make(text2vec.EmbeddingResponse, len(objects))
-//			if err = json.NewDecoder(res.Body).Decode(&er); err != nil {
-//				fmt.Printf("failed create embeddings")
-//			}
-//
-//			ch <- er[0]
-//		}(req)
-//
-//	}
-//
-//	wg.Wait()
-//	print(len(ch))
-//
-//	return nil
-//}
-
 func (e *Text2vecEmbedder) Embed(ctx context.Context, objects []*objstore.Object) error {
 	inputs := make([]string, 0, len(objects))
 	for _, o := range objects {
 		inputs = append(inputs, o.FlatProperties())
 	}
 
+	vectors, err := e.client.embed(ctx, inputs, 0, e.newRequest, e.decode)
+	if err != nil {
+		return err
+	}
+
+	for i, o := range objects {
+		o.Vector = vectors[i]
+	}
+
+	return nil
+}
+
+func (e *Text2vecEmbedder) newRequest(ctx context.Context, inputs []string) (*http.Request, error) {
 	body := text2vec.EmbeddingRequest{
 		Inputs: inputs,
 	}
 
 	b, err := json.Marshal(body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.GetURL(), bytes.NewReader(b))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", e.config.ApiKey))
 
-	res, err := e.client.Do(req)
-	if err != nil {
-		return err
-	}
-
-	if res.StatusCode != http.StatusOK {
-		return fmt.Errorf("failed create embeddings")
-	}
-
-	er := make(text2vec.EmbeddingResponse, len(objects))
-	if err = json.NewDecoder(res.Body).Decode(&er); err != nil {
-		return err
-	}
+	return req, nil
+}
 
-	for i := 0; i < len(objects); i++ {
-		objects[i].Vector = er[i]
+func (e *Text2vecEmbedder) decode(body io.Reader) ([][]float32, error) {
+	var er text2vec.EmbeddingResponse
+	if err := json.NewDecoder(body).Decode(&er); err != nil {
+		return nil, err
 	}
 
-	return nil
+	return er, nil
 }
 
 func (e *Text2vecEmbedder) GetURL() string {
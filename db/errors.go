@@ -1,13 +1,46 @@
 package db
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	ErrUnknownIndexType         = errors.New("unknown index type")
 	ErrCollectionAlreadyExists  = errors.New("collection with the same name already exists")
 	ErrCollectionDoesntExist    = errors.New("collection does not exist")
+	ErrObjectDoesntExist        = errors.New("object does not exist")
 	ErrValidationFailed         = errors.New("validation failed")
 	ErrMissingVectorAndEmbedder = errors.New("can't insert an object without vector when there's no embedder")
 	ErrDatabaseClosed           = errors.New("database is closed")
 	ErrCollectionClosed         = errors.New("collection is closed")
+	ErrCollectionFailed         = errors.New("collection failed and must be reopened")
+	ErrBulkImportRunning        = errors.New("collection is being bulk imported")
+	ErrBulkImportNotEmpty       = errors.New("bulk imports are supported only into empty collections")
+	ErrNotBinaryVector          = errors.New("binary vectors can hold only 0 and 1 values")
+	ErrNoIndexedMappings        = errors.New("collection has no properties indexed for keyword search")
+	ErrUnknownNamedVector       = errors.New("unknown named vector")
+	ErrKeyAlreadyExists         = errors.New("object with the same key already exists")
+	ErrKeyTooLong               = errors.New("object key is too long")
+	ErrMissingKey               = errors.New("object key is empty")
+	ErrKeyChanged               = errors.New("object key can't be changed")
+	ErrBatchPartiallyApplied    = errors.New("batch was partially applied")
+	ErrInvalidBackup            = errors.New("invalid backup archive")
 )
+
+// validationError is an ErrValidationFailed caused by err, errors.Is matches both.
+type validationError struct {
+	err error
+}
+
+func (e validationError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrValidationFailed, e.err)
+}
+
+func (e validationError) Is(target error) bool {
+	return target == ErrValidationFailed
+}
+
+func (e validationError) Unwrap() error {
+	return e.err
+}
@@ -38,12 +38,13 @@ func newIdCounter(filesPath string) (*IdCounter, error) {
 	}, nil
 }
 
-func (c *IdCounter) fetchAndInc() (uint64, error) {
+// fetchAndAdd reserves n consecutive ids and returns the first of them.
+func (c *IdCounter) fetchAndAdd(n uint64) (uint64, error) {
 	c.Lock()
 	defer c.Unlock()
 
 	prev := c.count
-	c.count++
+	c.count += n
 
 	_, err := c.file.Seek(0, 0)
 	if err != nil {
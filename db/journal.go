@@ -0,0 +1,168 @@
+package db
+
+import (
+	objstoreentities "Vectory/entities/objstore"
+	"encoding/binary"
+	"encoding/json"
+	"github.com/pkg/errors"
+	w "github.com/tidwall/wal"
+)
+
+const (
+	journalDir = "journal"
+
+	// journalTruncateSize is the number of records after which the journal is truncated on commit
+	journalTruncateSize = 10000
+)
+
+const (
+	journalBegin byte = iota
+	journalCommit
+	journalUpdate
+)
+
+// journal is the collection's commit log of inserts, updates and deletes. the ids an insert or delete writes,
+// or the object an update replaces, are logged before the operation is applied to the stores and indexes, and
+// the operation is committed once the indexes were flushed.
+// the objects of an insert or delete that wasn't committed are deleted on recovery, which rolls back an insert
+// and rolls forward a delete, and the object replaced by an update that wasn't committed is written back, so
+// every operation is either fully applied or fully absent.
+// operations are serialized by the collection's lock, and an operation that fails is rolled back before the
+// next one begins, or no other operation begins until the collection is reopened, so only the last one can be
+// uncommitted.
+type journal struct {
+	f           *w.Log
+	seqNum      uint64
+	uncommitted []uint64                   // ids logged since the last commit
+	replaced    []*objstoreentities.Object // objects replaced by the updates logged since the last commit
+}
+
+// operation is an operation logged to the journal, either the ids written by an insert or delete, or the
+// object replaced by an update.
+type operation struct {
+	ids      []uint64
+	replaced *objstoreentities.Object
+}
+
+func openJournal(path string) (*journal, error) {
+	f, err := w.Open(path, w.DefaultOptions)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed opening journal at %s", path)
+	}
+
+	n, err := f.LastIndex()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed retrieving journal's last sequence number")
+	}
+
+	return &journal{f: f, seqNum: n + 1}, nil
+}
+
+// begin logs an operation that writes the objects with ids.
+func (j *journal) begin(ids []uint64) error {
+	/*
+		bytes = [opcode, ids], len(bytes) = 1 + 8*len(ids)
+	*/
+	bytes := make([]byte, 1+8*len(ids))
+
+	bytes[0] = journalBegin
+	for i, id := range ids {
+		binary.LittleEndian.PutUint64(bytes[1+8*i:], id)
+	}
+
+	if err := j.write(bytes); err != nil {
+		return err
+	}
+
+	j.uncommitted = append(j.uncommitted, ids...)
+
+	return nil
+}
+
+// beginUpdate logs an update that replaces the stored object.
+func (j *journal) beginUpdate(stored *objstoreentities.Object) error {
+	/*
+		bytes = [opcode, stored as JSON]
+	*/
+	b, err := json.Marshal(stored)
+	if err != nil {
+		return errors.Wrapf(err, "failed encoding %d", stored.Id)
+	}
+
+	if err = j.write(append([]byte{journalUpdate}, b...)); err != nil {
+		return err
+	}
+
+	j.replaced = append(j.replaced, stored)
+
+	return nil
+}
+
+// commit marks the last operation as fully applied, and truncates the journal once it grows large.
+func (j *journal) commit() error {
+	seqNum := j.seqNum
+
+	if err := j.write([]byte{journalCommit}); err != nil {
+		return err
+	}
+
+	j.uncommitted = nil
+	j.replaced = nil
+
+	first, err := j.f.FirstIndex()
+	if err != nil {
+		return err
+	}
+
+	if seqNum-first < journalTruncateSize {
+		return nil
+	}
+
+	return errors.Wrapf(j.f.TruncateFront(seqNum), "failed truncating journal until %d", seqNum)
+}
+
+// pending returns the last operation if it wasn't committed, nil otherwise.
+func (j *journal) pending() (*operation, error) {
+	last, err := j.f.LastIndex()
+	if err != nil || last == 0 {
+		return nil, err
+	}
+
+	record, err := j.f.Read(last)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed reading journal record %d", last)
+	}
+
+	switch record[0] {
+	case journalBegin:
+		ids := make([]uint64, 0, (len(record)-1)/8)
+		for offset := 1; offset < len(record); offset += 8 {
+			ids = append(ids, binary.LittleEndian.Uint64(record[offset:]))
+		}
+
+		return &operation{ids: ids}, nil
+	case journalUpdate:
+		var replaced objstoreentities.Object
+		if err = json.Unmarshal(record[1:], &replaced); err != nil {
+			return nil, errors.Wrapf(err, "failed decoding journal record %d", last)
+		}
+
+		return &operation{replaced: &replaced}, nil
+	default:
+		return nil, nil
+	}
+}
+
+func (j *journal) write(data []byte) error {
+	if err := j.f.Write(j.seqNum, data); err != nil {
+		return errors.Wrapf(err, "failed writing journal record %d", j.seqNum)
+	}
+
+	j.seqNum++
+
+	return nil
+}
+
+func (j *journal) close() error {
+	return j.f.Close()
+}
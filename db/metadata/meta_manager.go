@@ -65,7 +65,7 @@ func (m *MetaManager) CreateCollection(ctx context.Context, cfg *collectionent.C
 		return 0, nil
 	}
 
-	c, err := m.db.Collection.Create().
+	create := m.db.Collection.Create().
 		SetName(cfg.Name).
 		SetIndexType(cfg.IndexType).
 		SetDataType(cfg.DataType).
@@ -73,7 +73,13 @@ func (m *MetaManager) CreateCollection(ctx context.Context, cfg *collectionent.C
 		SetEmbedderConfig(config).
 		SetIndexParams(params).
 		SetMappings(cfg.Mappings).
-		Save(ctx)
+		SetNamedVectors(cfg.NamedVectors)
+
+	if cfg.VectorType != "" { // float32 vectors by default
+		create.SetVectorType(cfg.VectorType)
+	}
+
+	c, err := create.Save(ctx)
 
 	if err != nil {
 		return 0, err
@@ -85,7 +91,7 @@ func (m *MetaManager) CreateCollection(ctx context.Context, cfg *collectionent.C
 		return 0, err
 	}
 
-	return c.ID, err
+	return c.ID, nil
 }
 
 func (m *MetaManager) DeleteCollection(ctx context.Context, name string) error {
@@ -110,6 +116,21 @@ func (m *MetaManager) GetCollections(ctx context.Context) ([]*ent.Collection, er
 	return m.db.Collection.Query().All(ctx)
 }
 
+// Config returns the configuration of the collection c.
+func Config(c *ent.Collection) *collectionent.Collection {
+	return &collectionent.Collection{
+		Name:           c.Name,
+		IndexType:      c.IndexType,
+		EmbedderType:   c.EmbedderType,
+		IndexParams:    c.IndexParams,
+		EmbedderConfig: c.EmbedderConfig,
+		DataType:       c.DataType,
+		Mappings:       c.Mappings,
+		VectorType:     c.VectorType,
+		NamedVectors:   c.NamedVectors,
+	}
+}
+
 func (m *MetaManager) Close() error {
 	return m.db.Close()
 }
@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"Vectory/entities/index"
+	"Vectory/entities/mappings"
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
 )
@@ -17,6 +19,8 @@ func (Collection) Fields() []ent.Field {
 		field.String("embedder_type"),
 		field.JSON("index_params", map[string]interface{}{}),
 		field.JSON("embedder_config", map[string]interface{}{}),
-		field.Strings("mappings"),
+		field.JSON("mappings", []mappings.Mapping{}),
+		field.String("vector_type").Default("float32"),
+		field.JSON("named_vectors", []index.NamedVector{}).Optional(),
 	}
 }
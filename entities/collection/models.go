@@ -1,11 +1,24 @@
 package collection
 
-import "Vectory/entities/objstore"
+import (
+	"Vectory/entities/index"
+	"Vectory/entities/mappings"
+	"Vectory/entities/objstore"
+	"fmt"
+	"strings"
+)
 
 const (
 	TextDataType = "text"
 )
 
+const (
+	FloatVectorType = "float32"
+
+	// BinaryVectorType vectors hold only 0 and 1 values and are stored as packed bits
+	BinaryVectorType = "binary"
+)
+
 type Collection struct {
 
 	// name
@@ -27,10 +40,155 @@ type Collection struct {
 	EmbedderConfig interface{} `json:"embedder_config,omitempty"`
 
 	// mappings
-	Mappings []string `json:"mappings"`
+	Mappings []mappings.Mapping `json:"mappings"`
+
+	// vector type, float32 when empty
+	VectorType string `json:"vector_type,omitempty"`
+
+	// additional float32 vectors of the objects, each is indexed separately and can be searched by its name
+	NamedVectors []index.NamedVector `json:"named_vectors,omitempty"`
+}
+
+const (
+	// RRFFusion ranks hybrid search results by the sum of the reciprocal ranks of their keyword and vector results
+	RRFFusion = "rrf"
+
+	// WeightedFusion ranks hybrid search results by a weighted sum of their normalized keyword and vector scores
+	WeightedFusion = "weighted"
+)
+
+// HybridParams configures a hybrid search, which fuses the results of a BM25 keyword search over
+// the indexed properties of a collection with the results of a vector search.
+type HybridParams struct {
+
+	// keyword query, the text of the searched object's indexed properties when empty
+	Query string `json:"query,omitempty"`
+
+	// fusion method, rrf when empty
+	Fusion string `json:"fusion,omitempty"`
+
+	// weight of the vector scores in weighted fusion, 0 is a pure keyword search and 1 a pure vector search
+	Alpha float32 `json:"alpha,omitempty"`
 }
 
 type SemanticSearchResult struct {
 	Hits    int                           `json:"hits"`
 	Objects []objstore.ObjectWithDistance `json:"objects"`
 }
+
+// BatchObjectResult is the outcome of inserting one object of a batch.
+type BatchObjectResult struct {
+
+	// id assigned to the object, valid only when Err is nil
+	Id uint64
+
+	// reason the object wasn't inserted, nil when it was
+	Err error
+}
+
+// BatchResult holds the outcome of each object of a batch in the batch's order.
+type BatchResult struct {
+	Objects []BatchObjectResult
+
+	// number of objects that weren't inserted
+	Failed int
+}
+
+// Partial indicates whether only some of the objects of the batch were inserted.
+func (r *BatchResult) Partial() bool {
+	return r.Failed > 0 && r.Failed < len(r.Objects)
+}
+
+// ImportMapping maps the fields of the JSON records of an import to the parts of the objects, the defaults read
+// the records written by an export.
+type ImportMapping struct {
+
+	// field of the object's key, "key" when empty
+	Key string
+
+	// field of the object's vector, "vector" when empty
+	Vector string
+
+	// fields of the object's named vectors by their names, the "vectors" object when empty
+	Vectors map[string]string
+
+	// fields of the object's properties, the "properties" object when empty
+	Properties []string
+}
+
+// ParseImportMapping returns the mapping of the fields key and vector, the comma separated name=field pairs of
+// vectors and the comma separated fields of properties, empty ones keep their defaults.
+func ParseImportMapping(key, vector, vectors, properties string) (ImportMapping, error) {
+	mapping := ImportMapping{Key: key, Vector: vector}
+
+	if vectors != "" {
+		mapping.Vectors = make(map[string]string)
+
+		for _, pair := range strings.Split(vectors, ",") {
+			name, field, ok := strings.Cut(pair, "=")
+			if !ok || name == "" || field == "" {
+				return mapping, fmt.Errorf("invalid named vector mapping %q, expected name=field", pair)
+			}
+
+			mapping.Vectors[name] = field
+		}
+	}
+
+	if properties != "" {
+		mapping.Properties = strings.Split(properties, ",")
+	}
+
+	return mapping, nil
+}
+
+// ImportOptions configures an import.
+type ImportOptions struct {
+	Mapping ImportMapping
+
+	// number of records inserted in each batch, DefaultImportBatchSize when not positive
+	BatchSize int
+
+	// number of records skipped at the start of the input, the Records of an interrupted import resume it
+	Skip int
+
+	// called with the import's progress after each batch, the progress of a bulk import is persisted only once it
+	// returns
+	Progress func(ImportResult)
+
+	// build the vector indexes without logging each insert and persist them once the import returns, which is much
+	// faster for initial loads. only empty collections are bulk imported, other writes are rejected while it runs and
+	// its objects are rolled back if the process stops before it returns
+	Bulk bool
+}
+
+const (
+	DefaultImportBatchSize = 1000
+
+	// MaxImportErrors is the number of failed records whose errors are kept in an ImportResult
+	MaxImportErrors = 100
+)
+
+// ImportError is the reason a record of an import wasn't inserted.
+type ImportError struct {
+
+	// number of the record in the input, from 0
+	Record int
+
+	Err error
+}
+
+// ImportResult is the outcome of an import.
+type ImportResult struct {
+
+	// number of records read from the input including the skipped ones, all of them were either imported or failed
+	Records int
+
+	// number of inserted objects
+	Imported int
+
+	// number of records that weren't inserted
+	Failed int
+
+	// errors of the first MaxImportErrors failed records
+	Errors []ImportError
+}
@@ -2,9 +2,13 @@ package collection
 
 import (
 	"Vectory/db/embeddings"
-	"Vectory/entities/embeddings/hugging_face/text2vec"
+	"Vectory/entities/distance"
 	"Vectory/entities/index"
+	"Vectory/entities/mappings"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"regexp"
 )
 
 func Validate(cfg *Collection) error {
@@ -12,40 +16,191 @@ func Validate(cfg *Collection) error {
 		return ErrCollectionNameEmpty
 	}
 
-	var err error
+	err := validateIndex(cfg.IndexType, cfg.IndexParams)
+	if err != nil {
+		return err
+	}
+
+	if err = validateEmbedder(cfg.EmbedderType, cfg.EmbedderConfig); err != nil {
+		return err
+	}
+
+	switch cfg.DataType {
+	case TextDataType:
+	default:
+		return ErrDataTypeUnsupported
+	}
+
+	if err = mappings.Validate(cfg.Mappings); err != nil {
+		return err
+	}
+
+	if err = validateEmbeddedMappings(cfg); err != nil {
+		return err
+	}
 
-	switch cfg.IndexType {
+	if err = validateNamedVectors(cfg.NamedVectors); err != nil {
+		return err
+	}
+
+	return validateVectorType(cfg)
+}
+
+func validateIndex(indexType string, params interface{}) error {
+	switch indexType {
 	case index.Hnsw:
-		err = index.ValidateHnswParams(cfg.IndexParams)
+		return index.ValidateHnswParams(params)
 	case index.DiskAnn:
+		return index.ValidateDiskAnnParams(params)
 	default:
 		return ErrIndexTypeUnsupported
 	}
+}
+
+// validateEmbedder checks that the embedder type is registered and its config is valid,
+// no embedder type means that the user provides their own vectors
+func validateEmbedder(embedderType string, cfg interface{}) error {
+	if embedderType == "" {
+		return nil
+	}
+
+	if !embeddings.IsRegistered(embedderType) {
+		return ErrEmbedderTypeUnsupported
+	}
+
+	if err := embeddings.ValidateConfig(embedderType, cfg); err != nil {
+		return fmt.Errorf("%w: %s", ErrEmbedderConfigInvalid, err)
+	}
+
+	return nil
+}
+
+// validateEmbeddedMappings checks that a collection with an embedder has a mapping marked for embedding,
+// objects have exactly the mapped properties so otherwise the embedder's input would always be empty
+func validateEmbeddedMappings(cfg *Collection) error {
+	if cfg.EmbedderType == "" {
+		return nil
+	}
 
+	for _, m := range cfg.Mappings {
+		if m.Embed {
+			return nil
+		}
+	}
+
+	return ErrEmbeddedMappingMissing
+}
+
+// namedVectorNameRegex matches the names of named vectors, which are also the names of their index directories.
+// they are limited to 32 characters since they are part of the keys of the vectors store, which are up to 64 bytes.
+var namedVectorNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,32}$`)
+
+// validateNamedVectors checks that named vectors have unique names and valid indexes of float32 vectors
+func validateNamedVectors(vectors []index.NamedVector) error {
+	names := make(map[string]struct{}, len(vectors))
+
+	for _, v := range vectors {
+		if !namedVectorNameRegex.MatchString(v.Name) {
+			return fmt.Errorf("%w: %q", ErrNamedVectorNameInvalid, v.Name)
+		}
+
+		if _, ok := names[v.Name]; ok {
+			return fmt.Errorf("%w: %s", ErrNamedVectorDuplicate, v.Name)
+		}
+
+		names[v.Name] = struct{}{}
+
+		if err := validateIndex(v.IndexType, v.IndexParams); err != nil {
+			return fmt.Errorf("named vector %s: %w", v.Name, err)
+		}
+
+		distanceType, err := DistanceTypeOf(v.IndexParams)
+		if err != nil {
+			return err
+		}
+
+		if distanceType == distance.Hamming {
+			return fmt.Errorf("named vector %s: %w", v.Name, ErrHammingDistanceNotBinary)
+		}
+	}
+
+	return nil
+}
+
+// DistanceTypeOf returns the distance type set in index params
+func DistanceTypeOf(params interface{}) (string, error) {
+	var p struct {
+		DistanceType string `json:"distance_type"`
+	}
+
+	b, err := json.Marshal(params)
+	if err != nil {
+		return "", err
+	}
+
+	if err = json.Unmarshal(b, &p); err != nil {
+		return "", err
+	}
+
+	return p.DistanceType, nil
+}
+
+// validateVectorType checks that binary vectors are indexed by hnsw with hamming distance,
+// which is the only distance supported for them, and that they aren't produced by an embedder.
+func validateVectorType(cfg *Collection) error {
+	distanceType, err := DistanceTypeOf(cfg.IndexParams)
 	if err != nil {
 		return err
 	}
 
-	switch cfg.EmbedderType {
-	case "": // no use of embedder, user should provide his own vectors
-	case text2vec.Text2VecHuggingFace: // TODO: validate embedder config
-	case embeddings.FakeEmbedder:
+	switch cfg.VectorType {
+	case "", FloatVectorType:
+		if distanceType == distance.Hamming {
+			return ErrHammingDistanceNotBinary
+		}
+	case BinaryVectorType:
+		if cfg.IndexType != index.Hnsw || distanceType != distance.Hamming {
+			return ErrBinaryVectorsDistance
+		}
+
+		if cfg.EmbedderType != "" {
+			return ErrBinaryVectorsEmbedder
+		}
 	default:
-		return ErrEmbedderTypeUnsupported
+		return ErrVectorTypeUnsupported
 	}
 
-	switch cfg.DataType {
-	case TextDataType:
+	return nil
+}
+
+// ValidateHybridParams checks the fusion method and weight of a hybrid search
+func ValidateHybridParams(params *HybridParams) error {
+	switch params.Fusion {
+	case "", RRFFusion, WeightedFusion:
 	default:
-		return ErrDataTypeUnsupported
+		return ErrFusionUnsupported
+	}
+
+	if params.Alpha < 0 || params.Alpha > 1 {
+		return ErrAlphaOutOfRange
 	}
 
 	return nil
 }
 
 var (
-	ErrCollectionNameEmpty     = errors.New("collection name field is empty")
-	ErrIndexTypeUnsupported    = errors.New("index_type inserted is not supported")
-	ErrEmbedderTypeUnsupported = errors.New("embedder_type inserted is not supported")
-	ErrDataTypeUnsupported     = errors.New("data_type inserted is not supported")
+	ErrCollectionNameEmpty      = errors.New("collection name field is empty")
+	ErrIndexTypeUnsupported     = errors.New("index_type inserted is not supported")
+	ErrEmbedderTypeUnsupported  = errors.New("embedder_type inserted is not supported")
+	ErrEmbedderConfigInvalid    = errors.New("embedder_config is invalid")
+	ErrEmbeddedMappingMissing   = errors.New("collections with an embedder need at least a mapping with embed set")
+	ErrDataTypeUnsupported      = errors.New("data_type inserted is not supported")
+	ErrVectorTypeUnsupported    = errors.New("vector_type inserted is not supported")
+	ErrBinaryVectorsDistance    = errors.New("binary vectors are supported only by hnsw index with hamming distance")
+	ErrBinaryVectorsEmbedder    = errors.New("binary vectors can't be produced by an embedder")
+	ErrHammingDistanceNotBinary = errors.New("hamming distance is supported only for binary vectors")
+	ErrFusionUnsupported        = errors.New("fusion inserted is not supported")
+	ErrAlphaOutOfRange          = errors.New("alpha must be between 0 and 1")
+	ErrNamedVectorNameInvalid   = errors.New("named vector name must be up to 32 letters, digits, _ and -")
+	ErrNamedVectorDuplicate     = errors.New("duplicate named vector")
 )
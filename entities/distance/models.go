@@ -3,4 +3,9 @@ package distance
 const (
 	DotProduct = "dot_product"
 	Euclidean  = "euclidean_distance"
+	Cosine     = "cosine"
+	Manhattan  = "manhattan"
+
+	// Hamming counts the differing bits of binary vectors
+	Hamming = "hamming"
 )
@@ -1,5 +1,10 @@
 package text2vec
 
+import (
+	"Vectory/entities/embeddings"
+	"errors"
+)
+
 const (
 	Text2VecHuggingFace = "text2vec-huggingface"
 	ModelName           = "sentence-transformers/msmarco-bert-base-dot-v5"
@@ -7,6 +12,17 @@ const (
 
 type Config struct {
 	ApiKey string `json:"api_key"`
+
+	embeddings.ClientConfig
+}
+
+// ValidateConfig validates the config of a text2vec-huggingface embedder.
+func ValidateConfig(cfg *Config) error {
+	if cfg.ApiKey == "" {
+		return errors.New("api_key must be set")
+	}
+
+	return cfg.ClientConfig.Validate()
 }
 
 type EmbeddingRequest struct {
@@ -0,0 +1,59 @@
+package embeddings
+
+import "errors"
+
+const (
+	DefaultMaxBatchSize   = 64
+	DefaultMaxConcurrency = 4
+	DefaultMaxRetries     = 3
+	DefaultTimeoutSeconds = 30
+)
+
+// ClientConfig of the HTTP client of an embedder, it's part of the embedder_config of the HTTP embedders and its
+// zero values are replaced by the defaults.
+type ClientConfig struct {
+
+	// max number of inputs sent in a single request, larger inputs are split into several requests
+	MaxBatchSize int `json:"max_batch_size,omitempty"`
+
+	// max number of requests the embedder sends at the same time
+	MaxConcurrency int `json:"max_concurrency,omitempty"`
+
+	// max number of times a request that failed with a 429 or 5xx status or a network error is retried,
+	// 0 disables the retries
+	MaxRetries *int `json:"max_retries,omitempty"`
+
+	// timeout of each request, including reading its response
+	TimeoutSeconds int `json:"timeout_seconds,omitempty"`
+}
+
+// Validate validates the client config.
+func (c *ClientConfig) Validate() error {
+	if c.MaxBatchSize < 0 || c.MaxConcurrency < 0 || c.TimeoutSeconds < 0 || (c.MaxRetries != nil && *c.MaxRetries < 0) {
+		return errors.New("max_batch_size, max_concurrency, max_retries and timeout_seconds can't be negative")
+	}
+
+	return nil
+}
+
+// WithDefaults returns the client config with the defaults of the unset values.
+func (c ClientConfig) WithDefaults() ClientConfig {
+	if c.MaxBatchSize == 0 {
+		c.MaxBatchSize = DefaultMaxBatchSize
+	}
+
+	if c.MaxConcurrency == 0 {
+		c.MaxConcurrency = DefaultMaxConcurrency
+	}
+
+	if c.MaxRetries == nil {
+		retries := DefaultMaxRetries
+		c.MaxRetries = &retries
+	}
+
+	if c.TimeoutSeconds == 0 {
+		c.TimeoutSeconds = DefaultTimeoutSeconds
+	}
+
+	return c
+}
@@ -0,0 +1,67 @@
+package openai
+
+import (
+	"Vectory/entities/embeddings"
+	"errors"
+	"net/url"
+)
+
+const (
+	OpenAI = "openai"
+
+	DefaultBaseURL = "https://api.openai.com/v1"
+)
+
+// Config of an embedder speaking the OpenAI embeddings protocol, e.g. OpenAI itself or a self-hosted model server.
+type Config struct {
+
+	// URL the embeddings path is appended to, DefaultBaseURL when empty
+	BaseURL string `json:"base_url,omitempty"`
+
+	// model the embeddings are created with
+	Model string `json:"model"`
+
+	// dimensions of the embeddings, the model's default when 0
+	Dimensions int `json:"dimensions,omitempty"`
+
+	// sent as a bearer token when set
+	ApiKey string `json:"api_key,omitempty"`
+
+	embeddings.ClientConfig
+}
+
+// ValidateConfig validates the config of an OpenAI embedder.
+func ValidateConfig(cfg *Config) error {
+	if cfg.Model == "" {
+		return errors.New("model must be set")
+	}
+
+	if cfg.Dimensions < 0 {
+		return errors.New("dimensions can't be negative")
+	}
+
+	if cfg.BaseURL != "" {
+		u, err := url.Parse(cfg.BaseURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.New("base_url must be an http or https URL")
+		}
+	}
+
+	return cfg.ClientConfig.Validate()
+}
+
+type EmbeddingRequest struct {
+	Input          []string `json:"input"`
+	Model          string   `json:"model"`
+	Dimensions     int      `json:"dimensions,omitempty"`
+	EncodingFormat string   `json:"encoding_format"`
+}
+
+type Embedding struct {
+	Index     int       `json:"index"`
+	Embedding []float32 `json:"embedding"`
+}
+
+type EmbeddingResponse struct {
+	Data []Embedding `json:"data"`
+}
@@ -0,0 +1,163 @@
+package filters
+
+import "encoding/json"
+
+// Match reports whether properties satisfy the filter. f is assumed to be valid.
+func (f *Filter) Match(properties map[string]interface{}) bool {
+	switch f.Operator {
+	case And:
+		for _, o := range f.Operands {
+			if !o.Match(properties) {
+				return false
+			}
+		}
+
+		return true
+	case Or:
+		for _, o := range f.Operands {
+			if o.Match(properties) {
+				return true
+			}
+		}
+
+		return false
+	case Not:
+		return !f.Operands[0].Match(properties)
+	}
+
+	p, ok := properties[f.Property]
+	if !ok {
+		return false
+	}
+
+	switch f.Operator {
+	case Equal:
+		return equal(p, f.Value)
+	case NotEqual:
+		return !equal(p, f.Value)
+	case In:
+		for _, v := range f.Value.([]interface{}) {
+			if equal(p, v) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	c, ok := compare(p, f.Value)
+	if !ok {
+		return false
+	}
+
+	switch f.Operator {
+	case LessThan:
+		return c < 0
+	case LessThanOrEqual:
+		return c <= 0
+	case GreaterThan:
+		return c > 0
+	case GreaterThanOrEqual:
+		return c >= 0
+	}
+
+	return false
+}
+
+// Key returns the key of a scalar value, which is the same for all the values that are equal to it. the keys of
+// numbers are float64 regardless of their type, the keys of strings and bools are themselves.
+// it returns false if v isn't a scalar.
+func Key(v interface{}) (interface{}, bool) {
+	if f, ok := toFloat(v); ok {
+		return f, true
+	}
+
+	switch v.(type) {
+	case string, bool:
+		return v, true
+	}
+
+	return nil, false
+}
+
+func equal(a, b interface{}) bool {
+	if fa, ok := toFloat(a); ok {
+		fb, ok := toFloat(b)
+		return ok && fa == fb
+	}
+
+	return isScalar(a) && a == b
+}
+
+// compare returns -1, 0 or 1 if a is less than, equal to or greater than b.
+// it returns false if a and b are not both numbers or both strings.
+func compare(a, b interface{}) (int, bool) {
+	if fa, ok := toFloat(a); ok {
+		fb, ok := toFloat(b)
+		if !ok {
+			return 0, false
+		}
+
+		switch {
+		case fa < fb:
+			return -1, true
+		case fa > fb:
+			return 1, true
+		}
+
+		return 0, true
+	}
+
+	sa, ok := a.(string)
+	if !ok {
+		return 0, false
+	}
+
+	sb, ok := b.(string)
+	if !ok {
+		return 0, false
+	}
+
+	switch {
+	case sa < sb:
+		return -1, true
+	case sa > sb:
+		return 1, true
+	}
+
+	return 0, true
+}
+
+func isScalar(v interface{}) bool {
+	switch v.(type) {
+	case string, bool:
+		return true
+	}
+
+	_, ok := toFloat(v)
+	return ok
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	}
+
+	return 0, false
+}
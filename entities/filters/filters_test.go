@@ -0,0 +1,57 @@
+package filters
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestFilter(t *testing.T) {
+	properties := map[string]interface{}{
+		"country": "Italy",
+		"price":   float64(25),
+		"organic": true,
+	}
+
+	t.Run("comparison", func(t *testing.T) {
+		require.True(t, (&Filter{Operator: Equal, Property: "country", Value: "Italy"}).Match(properties))
+		require.False(t, (&Filter{Operator: Equal, Property: "country", Value: "France"}).Match(properties))
+		require.True(t, (&Filter{Operator: NotEqual, Property: "country", Value: "France"}).Match(properties))
+		require.True(t, (&Filter{Operator: Equal, Property: "price", Value: 25}).Match(properties))
+		require.True(t, (&Filter{Operator: Equal, Property: "organic", Value: true}).Match(properties))
+		require.True(t, (&Filter{Operator: LessThan, Property: "price", Value: 30}).Match(properties))
+		require.False(t, (&Filter{Operator: GreaterThan, Property: "price", Value: 30}).Match(properties))
+		require.True(t, (&Filter{Operator: LessThanOrEqual, Property: "price", Value: 25.0}).Match(properties))
+		require.True(t, (&Filter{Operator: GreaterThanOrEqual, Property: "country", Value: "France"}).Match(properties))
+		require.False(t, (&Filter{Operator: LessThan, Property: "country", Value: 30}).Match(properties))
+		require.False(t, (&Filter{Operator: Equal, Property: "missing", Value: "Italy"}).Match(properties))
+	})
+
+	t.Run("in", func(t *testing.T) {
+		require.True(t, (&Filter{Operator: In, Property: "country", Value: []interface{}{"France", "Italy"}}).Match(properties))
+		require.False(t, (&Filter{Operator: In, Property: "country", Value: []interface{}{"France", "Spain"}}).Match(properties))
+	})
+
+	t.Run("logical", func(t *testing.T) {
+		italy := &Filter{Operator: Equal, Property: "country", Value: "Italy"}
+		expensive := &Filter{Operator: GreaterThan, Property: "price", Value: 30}
+
+		require.False(t, (&Filter{Operator: And, Operands: []*Filter{italy, expensive}}).Match(properties))
+		require.True(t, (&Filter{Operator: Or, Operands: []*Filter{italy, expensive}}).Match(properties))
+		require.True(t, (&Filter{Operator: Not, Operands: []*Filter{expensive}}).Match(properties))
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		require.NoError(t, Validate(&Filter{Operator: And, Operands: []*Filter{
+			{Operator: Equal, Property: "country", Value: "Italy"},
+			{Operator: Not, Operands: []*Filter{{Operator: In, Property: "price", Value: []interface{}{10, 20}}}},
+		}}))
+
+		require.Error(t, Validate(&Filter{Operator: "like", Property: "country", Value: "Italy"}))
+		require.ErrorIs(t, Validate(&Filter{Operator: Equal, Value: "Italy"}), ErrPropertyEmpty)
+		require.Error(t, Validate(&Filter{Operator: LessThan, Property: "price", Value: true}))
+		require.Error(t, Validate(&Filter{Operator: In, Property: "price", Value: 10}))
+		require.Error(t, Validate(&Filter{Operator: And}))
+		require.Error(t, Validate(&Filter{Operator: Not, Operands: []*Filter{{Operator: "like"}}}))
+		require.ErrorIs(t, Validate(&Filter{Operator: Or, Operands: []*Filter{nil}}), ErrOperandEmpty)
+	})
+}
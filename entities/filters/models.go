@@ -0,0 +1,31 @@
+package filters
+
+const (
+	Equal              = "eq"
+	NotEqual           = "neq"
+	LessThan           = "lt"
+	LessThanOrEqual    = "lte"
+	GreaterThan        = "gt"
+	GreaterThanOrEqual = "gte"
+	In                 = "in"
+	And                = "and"
+	Or                 = "or"
+	Not                = "not"
+)
+
+// Filter is a boolean expression over objects properties.
+// comparison filters (eq, neq, lt, lte, gt, gte, in) compare Property with Value,
+// logical filters (and, or, not) combine their Operands.
+type Filter struct {
+	// operator
+	Operator string `json:"operator"`
+
+	// property name, used by comparison operators
+	Property string `json:"property,omitempty"`
+
+	// value to compare with, a list of values for the in operator
+	Value interface{} `json:"value,omitempty"`
+
+	// operands of logical operators
+	Operands []*Filter `json:"operands,omitempty"`
+}
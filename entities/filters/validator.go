@@ -0,0 +1,71 @@
+package filters
+
+import (
+	"errors"
+	"fmt"
+)
+
+func Validate(f *Filter) error {
+	switch f.Operator {
+	case Equal, NotEqual:
+		if f.Property == "" {
+			return ErrPropertyEmpty
+		}
+
+		if !isScalar(f.Value) {
+			return fmt.Errorf("%s expects a string, number or boolean value", f.Operator)
+		}
+	case LessThan, LessThanOrEqual, GreaterThan, GreaterThanOrEqual:
+		if f.Property == "" {
+			return ErrPropertyEmpty
+		}
+
+		if _, ok := toFloat(f.Value); !ok {
+			if _, ok = f.Value.(string); !ok {
+				return fmt.Errorf("%s expects a string or number value", f.Operator)
+			}
+		}
+	case In:
+		if f.Property == "" {
+			return ErrPropertyEmpty
+		}
+
+		values, ok := f.Value.([]interface{})
+		if !ok {
+			return errors.New("in expects a list of values")
+		}
+
+		for _, v := range values {
+			if !isScalar(v) {
+				return errors.New("in expects a list of strings, numbers or booleans")
+			}
+		}
+	case And, Or:
+		if len(f.Operands) == 0 {
+			return fmt.Errorf("%s expects at least one operand", f.Operator)
+		}
+	case Not:
+		if len(f.Operands) != 1 {
+			return errors.New("not expects exactly one operand")
+		}
+	default:
+		return fmt.Errorf("unsupported filter operator %q", f.Operator)
+	}
+
+	for _, o := range f.Operands {
+		if o == nil {
+			return ErrOperandEmpty
+		}
+
+		if err := Validate(o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var (
+	ErrPropertyEmpty = errors.New("filter property field is empty")
+	ErrOperandEmpty  = errors.New("filter operand is empty")
+)
@@ -22,6 +22,31 @@ type HnswParams struct {
 	Heuristic bool `json:"heuristic"`
 
 	DistanceType string `json:"distance_type"`
+
+	// product quantization of the vectors held in memory, disabled when nil
+	PQ *PQParams `json:"pq,omitempty"`
+
+	// int8 scalar quantization of the vectors held in memory, disabled when nil
+	SQ *SQParams `json:"sq,omitempty"`
+}
+
+type PQParams struct {
+	// number of subspaces vectors are split into, each is encoded to a single byte
+	Subspaces int `json:"subspaces"`
+
+	// number of centroids trained for each subspace, up to 256
+	Centroids int `json:"centroids"`
+
+	// number of indexed vectors the codebooks are trained on, vectors are compressed once the index reaches it
+	TrainingSize int `json:"training_size"`
+}
+
+type SQParams struct {
+	// number of indexed vectors the per-dimension ranges are learnt from, vectors are compressed once the index reaches it
+	TrainingSize int `json:"training_size"`
+
+	// rescore the final candidates of searches with the full vectors
+	Rescore bool `json:"rescore"`
 }
 
 var DefaultHnswParams = HnswParams{
@@ -32,3 +57,37 @@ var DefaultHnswParams = HnswParams{
 	Heuristic:      true,
 	DistanceType:   distance.Euclidean,
 }
+
+type DiskAnnParams struct {
+	// Maximum number of out neighbors of each vertex
+	MaxDegree int `json:"max_degree"`
+
+	// size of the search candidate list
+	ListSize int `json:"list_size"`
+
+	// pruning factor, larger values keep more long range neighbors
+	Alpha float32 `json:"alpha"`
+
+	// Maximum number of vectors in the in-memory write index before it is snapshotted to disk
+	MemoryIndexSize int `json:"memory_index_size"`
+
+	DistanceType string `json:"distance_type"`
+}
+
+var DefaultDiskAnnParams = DiskAnnParams{
+	MaxDegree:       64,
+	ListSize:        100,
+	Alpha:           1.2,
+	MemoryIndexSize: 100000,
+	DistanceType:    distance.Euclidean,
+}
+
+// NamedVector configures an additional vector of a collection's objects, which is indexed by its own index
+type NamedVector struct {
+	Name string `json:"name"`
+
+	IndexType string `json:"index_type"`
+
+	// params of the index, which also set the distance type of the vector
+	IndexParams interface{} `json:"index_params"`
+}
@@ -35,9 +35,86 @@ func ValidateHnswParams(params interface{}) error {
 		return errors.New("ef_construction must be greater than zero")
 	}
 
-	switch hnswParams.DistanceType {
+	if hnswParams.PQ != nil && hnswParams.SQ != nil {
+		return errors.New("only one of pq and sq can be set")
+	}
+
+	if hnswParams.DistanceType == distance.Hamming && (hnswParams.PQ != nil || hnswParams.SQ != nil) {
+		return errors.New("binary vectors can't be quantized")
+	}
+
+	if hnswParams.PQ != nil {
+		if err = validatePQParams(hnswParams.PQ); err != nil {
+			return err
+		}
+	}
+
+	if hnswParams.SQ != nil && hnswParams.SQ.TrainingSize <= 0 {
+		return errors.New("sq training_size must be greater than zero")
+	}
+
+	return validateDistanceType(hnswParams.DistanceType)
+}
+
+func validatePQParams(params *PQParams) error {
+	if params.Subspaces <= 0 {
+		return errors.New("pq subspaces must be greater than zero")
+	}
+
+	if params.Centroids <= 0 || params.Centroids > 256 {
+		return errors.New("pq centroids must be between 1 and 256")
+	}
+
+	if params.TrainingSize < params.Centroids {
+		return errors.New("pq training_size must be at least the number of centroids")
+	}
+
+	return nil
+}
+
+func ValidateDiskAnnParams(params interface{}) error {
+	var diskAnnParams DiskAnnParams
+
+	b, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(b, &diskAnnParams)
+	if err != nil {
+		return err
+	}
+
+	if diskAnnParams.MaxDegree <= 0 {
+		return errors.New("max_degree must be greater than zero")
+	}
+
+	if diskAnnParams.ListSize <= 0 {
+		return errors.New("list_size must be greater than zero")
+	}
+
+	if diskAnnParams.Alpha < 1 {
+		return errors.New("alpha must be at least 1")
+	}
+
+	if diskAnnParams.MemoryIndexSize <= 0 {
+		return errors.New("memory_index_size must be greater than zero")
+	}
+
+	if diskAnnParams.DistanceType == distance.Hamming {
+		return errors.New("hamming distance is not supported by disk_ann")
+	}
+
+	return validateDistanceType(diskAnnParams.DistanceType)
+}
+
+func validateDistanceType(distanceType string) error {
+	switch distanceType {
 	case distance.DotProduct:
 	case distance.Euclidean:
+	case distance.Cosine:
+	case distance.Manhattan:
+	case distance.Hamming:
 	default:
 		return errors.New("unsupported distance_type")
 	}
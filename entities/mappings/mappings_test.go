@@ -0,0 +1,76 @@
+package mappings
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestMappings(t *testing.T) {
+	t.Run("validate mappings", func(t *testing.T) {
+		require.NoError(t, Validate([]Mapping{
+			{Name: "title", Type: Text, Indexed: true, Embed: true},
+			{Name: "tags", Type: StringArray, Filterable: true, Embed: true},
+			{Name: "price", Type: Float, Filterable: true},
+			{Name: "location", Type: GeoPoint},
+		}))
+
+		require.ErrorIs(t, Validate([]Mapping{{Type: Text}}), ErrMappingNameEmpty)
+		require.Error(t, Validate([]Mapping{{Name: "title", Type: Text}, {Name: "title", Type: Text}}))
+		require.Error(t, Validate([]Mapping{{Name: "title", Type: "varchar"}}))
+		require.Error(t, Validate([]Mapping{{Name: "price", Type: Int, Embed: true}}))
+		require.Error(t, Validate([]Mapping{{Name: "price", Type: Float, Indexed: true}}))
+	})
+
+	t.Run("validate values", func(t *testing.T) {
+		valid := []struct {
+			typ   string
+			value interface{}
+		}{
+			{Text, "blah"},
+			{Int, 10},
+			{Int, float64(10)},
+			{Int, json.Number("10")},
+			{Float, 10.5},
+			{Bool, true},
+			{Date, "2023-04-01T10:00:00Z"},
+			{Date, time.Now()},
+			{StringArray, []string{"a", "b"}},
+			{StringArray, []interface{}{"a", "b"}},
+			{GeoPoint, map[string]interface{}{"lat": 41.9, "lon": 12.5}},
+		}
+
+		for _, v := range valid {
+			require.NoError(t, ValidateValue(Mapping{Name: "p", Type: v.typ}, v.value), "%s %v", v.typ, v.value)
+		}
+
+		invalid := []struct {
+			typ   string
+			value interface{}
+		}{
+			{Text, 10},
+			{Int, 10.5},
+			{Int, "10"},
+			{Float, "10.5"},
+			{Bool, "true"},
+			{Date, "01/04/2023"},
+			{StringArray, []interface{}{"a", 1}},
+			{GeoPoint, map[string]interface{}{"lat": 91, "lon": 12.5}},
+			{GeoPoint, map[string]interface{}{"lat": 41.9}},
+		}
+
+		for _, v := range invalid {
+			require.Error(t, ValidateValue(Mapping{Name: "p", Type: v.typ}, v.value), "%s %v", v.typ, v.value)
+		}
+	})
+
+	t.Run("unmarshal untyped mappings", func(t *testing.T) {
+		var ms []Mapping
+		require.NoError(t, json.Unmarshal([]byte(`["title", {"name": "price", "type": "float", "filterable": true}]`), &ms))
+		require.Equal(t, []Mapping{
+			{Name: "title", Type: Text, Filterable: true, Embed: true},
+			{Name: "price", Type: Float, Filterable: true},
+		}, ms)
+	})
+}
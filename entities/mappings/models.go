@@ -0,0 +1,45 @@
+package mappings
+
+import "encoding/json"
+
+const (
+	Text        = "text"
+	Int         = "int"
+	Float       = "float"
+	Bool        = "bool"
+	Date        = "date"
+	StringArray = "string_array"
+	GeoPoint    = "geo_point"
+)
+
+// Mapping describes a property of the objects in a collection.
+type Mapping struct {
+	// property name
+	Name string `json:"name"`
+
+	// property type
+	Type string `json:"type"`
+
+	// whether the property is indexed for keyword search
+	Indexed bool `json:"indexed"`
+
+	// whether the property can be used in search filters
+	Filterable bool `json:"filterable"`
+
+	// whether the property is part of the embedder's input
+	Embed bool `json:"embed"`
+}
+
+// UnmarshalJSON supports collections created before mappings were typed, where a mapping was only a
+// property name. such mappings are treated as filterable text properties that are embedded.
+func (m *Mapping) UnmarshalJSON(b []byte) error {
+	var name string
+	if err := json.Unmarshal(b, &name); err == nil {
+		*m = Mapping{Name: name, Type: Text, Filterable: true, Embed: true}
+		return nil
+	}
+
+	type mapping Mapping
+
+	return json.Unmarshal(b, (*mapping)(m))
+}
@@ -0,0 +1,140 @@
+package mappings
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+func Validate(mappings []Mapping) error {
+	names := make(map[string]struct{}, len(mappings))
+
+	for _, m := range mappings {
+		if m.Name == "" {
+			return ErrMappingNameEmpty
+		}
+
+		if _, ok := names[m.Name]; ok {
+			return fmt.Errorf("mapping %s is defined more than once", m.Name)
+		}
+
+		names[m.Name] = struct{}{}
+
+		switch m.Type {
+		case Text, StringArray:
+		case Int, Float, Bool, Date, GeoPoint:
+			if m.Embed {
+				return fmt.Errorf("mapping %s of type %s can't be embedded", m.Name, m.Type)
+			}
+
+			if m.Indexed {
+				return fmt.Errorf("mapping %s of type %s can't be indexed for keyword search", m.Name, m.Type)
+			}
+		default:
+			return fmt.Errorf("mapping %s has unsupported type %q", m.Name, m.Type)
+		}
+	}
+
+	return nil
+}
+
+// ValidateValue checks that v is a valid value for a property of mapping m.
+// values are expected either as Go types or as decoded from JSON.
+func ValidateValue(m Mapping, v interface{}) error {
+	var valid bool
+
+	switch m.Type {
+	case Text:
+		_, valid = v.(string)
+	case Int:
+		var f float64
+		f, valid = toFloat(v)
+		valid = valid && f == math.Trunc(f)
+	case Float:
+		_, valid = toFloat(v)
+	case Bool:
+		_, valid = v.(bool)
+	case Date:
+		switch d := v.(type) {
+		case time.Time:
+			valid = true
+		case string:
+			_, err := time.Parse(time.RFC3339, d)
+			valid = err == nil
+		}
+	case StringArray:
+		valid = isStringArray(v)
+	case GeoPoint:
+		valid = isGeoPoint(v)
+	}
+
+	if !valid {
+		return fmt.Errorf("property %s is not a valid %s", m.Name, m.Type)
+	}
+
+	return nil
+}
+
+func isStringArray(v interface{}) bool {
+	switch a := v.(type) {
+	case []string:
+		return true
+	case []interface{}:
+		for _, e := range a {
+			if _, ok := e.(string); !ok {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return false
+}
+
+func isGeoPoint(v interface{}) bool {
+	p, ok := v.(map[string]interface{})
+	if !ok || len(p) != 2 {
+		return false
+	}
+
+	lat, ok := toFloat(p["lat"])
+	if !ok || lat < -90 || lat > 90 {
+		return false
+	}
+
+	lon, ok := toFloat(p["lon"])
+	if !ok || lon < -180 || lon > 180 {
+		return false
+	}
+
+	return true
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	}
+
+	return 0, false
+}
+
+var ErrMappingNameEmpty = errors.New("mapping name field is empty")
@@ -1,23 +1,37 @@
 package objstore
 
 import (
+	"Vectory/db/core/index/distance"
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"math"
 )
 
+// MaxKeyLength is the maximum length in bytes of the keys of objects
+const MaxKeyLength = 256
+
 type Object struct {
-	Id uint64
+	Id  uint64
+	Key string // optional caller-provided unique key, e.g. a document key or a UUID
 	//DataType int // TODO: currently supports only text objects
 	Properties map[string]interface{}
 	Vector     []float32
+	Binary     bool                 // Vector holds only 0 and 1 values and is serialized as packed bits
+	Vectors    map[string][]float32 // named vectors of the collection's config
 }
 
+// binaryVectorFlag is set in the serialized dimension of binary vectors
+const binaryVectorFlag = 1 << 31
+
 type ObjectWithDistance struct {
 	Id         uint64
+	Key        string
 	Properties map[string]interface{}
+	Vector     []float32
+	Vectors    map[string][]float32
 	Distance   float32
+	Score      float32 // fused score of hybrid searches, higher is better
 }
 
 func (o *Object) SerializeProperties() ([]byte, error) {
@@ -61,6 +75,16 @@ func (o *Object) DeserializeProperties(object []byte) error {
 }
 
 func (o *Object) SerializeVector() ([]byte, error) {
+	if o.Binary {
+		packed := distance.PackBits(o.Vector)
+		b := make([]byte, 4+len(packed)) // VecDim | binaryVectorFlag + packed Vector
+
+		binary.LittleEndian.PutUint32(b, uint32(len(o.Vector))|binaryVectorFlag)
+		copy(b[4:], packed)
+
+		return b, nil
+	}
+
 	var offset int
 	b := make([]byte, 4+4*len(o.Vector)) // VecDim + Vector
 
@@ -81,6 +105,13 @@ func (o *Object) DeserializeVector(vector []byte) error {
 	dim := int(binary.LittleEndian.Uint32(vector[offset:]))
 	offset += 4
 
+	if dim&binaryVectorFlag != 0 {
+		o.Vector = distance.UnpackBits(vector[offset:], dim&^binaryVectorFlag)
+		o.Binary = true
+
+		return nil
+	}
+
 	vec := make([]float32, dim)
 	for i := 0; i < dim; i++ {
 		vec[i] = math.Float32frombits(binary.LittleEndian.Uint32(vector[offset:]))
@@ -30,4 +30,18 @@ func TestObject(t *testing.T) {
 
 		require.Equal(t, obj, obj2)
 	})
+	t.Run("binary vector serialization", func(t *testing.T) {
+		obj := Object{
+			Vector: []float32{1, 0, 0, 1, 1, 0, 1, 0, 1, 1},
+			Binary: true,
+		}
+
+		v, err := obj.SerializeVector()
+		require.NoError(t, err)
+		require.Len(t, v, 4+2)
+
+		obj2 := Object{}
+		require.NoError(t, obj2.DeserializeVector(v))
+		require.Equal(t, obj, obj2)
+	})
 }
@@ -0,0 +1,41 @@
+package search
+
+const (
+	// DefaultRangeLimit is the maximum number of objects returned by a range search when no limit is set
+	DefaultRangeLimit = 1000
+
+	// MaxRangeLimit caps the maximum number of objects returned by a range search
+	MaxRangeLimit = 10000
+)
+
+// Options configures a k-nn search
+type Options struct {
+
+	// number of nearest neighbors to return
+	K int `json:"k"`
+
+	// named vector to search, the objects' vector when empty
+	Vector string `json:"vector,omitempty"`
+
+	// size of the candidates list, which trades latency for recall. the index's ef or list size when 0
+	Ef int `json:"ef,omitempty"`
+
+	// neighbors farther than it are dropped, there's no limit when nil
+	MaxDistance *float32 `json:"max_distance,omitempty"`
+
+	// whether to return the vectors of the neighbors
+	IncludeVector bool `json:"include_vector,omitempty"`
+
+	// whether to return the distances of the neighbors
+	IncludeDistance bool `json:"include_distance,omitempty"`
+}
+
+// NewOptions returns the options of a search for the k nearest neighbors along with their distances
+func NewOptions(k int) Options {
+	return Options{K: k, IncludeDistance: true}
+}
+
+// Within reports whether distance is within the maximum distance of the search
+func (o *Options) Within(distance float32) bool {
+	return o.MaxDistance == nil || distance <= *o.MaxDistance
+}
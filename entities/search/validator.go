@@ -0,0 +1,33 @@
+package search
+
+import (
+	"errors"
+	"fmt"
+)
+
+func Validate(opts *Options) error {
+	if opts.K <= 0 {
+		return ErrKNotPositive
+	}
+
+	if opts.Ef < 0 {
+		return ErrEfNegative
+	}
+
+	return nil
+}
+
+// ValidateRangeLimit checks the maximum number of objects returned by a range search, 0 is the default limit
+func ValidateRangeLimit(limit int) error {
+	if limit < 0 || limit > MaxRangeLimit {
+		return ErrRangeLimitOutOfRange
+	}
+
+	return nil
+}
+
+var (
+	ErrKNotPositive         = errors.New("k must be greater than zero")
+	ErrEfNegative           = errors.New("ef can't be negative")
+	ErrRangeLimitOutOfRange = fmt.Errorf("limit must be between 0 and %d", MaxRangeLimit)
+)
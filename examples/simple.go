@@ -5,7 +5,9 @@ import (
 	"Vectory/entities/collection"
 	"Vectory/entities/embeddings/hugging_face/text2vec"
 	"Vectory/entities/index"
+	"Vectory/entities/mappings"
 	"Vectory/entities/objstore"
+	"Vectory/entities/search"
 	"context"
 	"fmt"
 	"os"
@@ -22,9 +24,9 @@ func main() {
 		DataType:       collection.TextDataType,
 		IndexParams:    index.DefaultHnswParams,
 		EmbedderConfig: text2vec.Config{ApiKey: os.Getenv("API_KEY")},
-		Mappings: []string{
-			"title",
-			"review",
+		Mappings: []mappings.Mapping{
+			{Name: "title", Type: mappings.Text, Filterable: true, Embed: true},
+			{Name: "review", Type: mappings.Text, Embed: true},
 		},
 	})
 
@@ -66,7 +68,7 @@ func main() {
 		Properties: map[string]interface{}{
 			"question": "whats the best movie to watch?",
 		},
-	}, 5)
+	}, search.NewOptions(5), nil, nil)
 
 	fmt.Println(res)
 }
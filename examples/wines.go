@@ -5,7 +5,9 @@ import (
 	"Vectory/entities/collection"
 	"Vectory/entities/embeddings/hugging_face/text2vec"
 	"Vectory/entities/index"
+	"Vectory/entities/mappings"
 	"Vectory/entities/objstore"
+	"Vectory/entities/search"
 	"context"
 	"encoding/json"
 	"errors"
@@ -31,19 +33,21 @@ func main() {
 			ApiKey: os.Getenv("API_KEY"),
 		},
 		IndexParams: &index.DefaultHnswParams,
-		Mappings: []string{"points",
-			"title",
-			"description",
-			"taster_name",
-			"taster_twitter_handle",
-			"price",
-			"designation",
-			"variety",
-			"region_1",
-			"region_2",
-			"province",
-			"country",
-			"winery"},
+		Mappings: []mappings.Mapping{
+			{Name: "points", Type: mappings.Text, Filterable: true},
+			{Name: "title", Type: mappings.Text, Embed: true},
+			{Name: "description", Type: mappings.Text, Embed: true},
+			{Name: "taster_name", Type: mappings.Text, Filterable: true},
+			{Name: "taster_twitter_handle", Type: mappings.Text},
+			{Name: "price", Type: mappings.Float, Filterable: true},
+			{Name: "designation", Type: mappings.Text, Embed: true},
+			{Name: "variety", Type: mappings.Text, Filterable: true, Embed: true},
+			{Name: "region_1", Type: mappings.Text, Filterable: true, Embed: true},
+			{Name: "region_2", Type: mappings.Text, Filterable: true, Embed: true},
+			{Name: "province", Type: mappings.Text, Filterable: true, Embed: true},
+			{Name: "country", Type: mappings.Text, Filterable: true, Embed: true},
+			{Name: "winery", Type: mappings.Text, Filterable: true, Embed: true},
+		},
 	})
 	if err != nil {
 		log.Fatal(err)
@@ -59,7 +63,7 @@ func main() {
 		Properties: map[string]interface{}{
 			"question": "whats the best red wine in italy?",
 		},
-	}, 5)
+	}, search.NewOptions(5), nil, nil)
 
 	fmt.Println(res)
 
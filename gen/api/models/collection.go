@@ -6,6 +6,9 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"strconv"
+
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
@@ -27,6 +30,9 @@ type Collection struct {
 	// data type
 	DataType string `json:"data_type,omitempty"`
 
+	// one of float32, binary. binary vectors hold only 0 and 1 and require the hamming distance
+	VectorType string `json:"vector_type,omitempty"`
+
 	// index params
 	IndexParams interface{} `json:"index_params,omitempty"`
 
@@ -34,11 +40,77 @@ type Collection struct {
 	EmbedderConfig interface{} `json:"embedder_config,omitempty"`
 
 	// mappings
-	Mappings []string `json:"mappings"`
+	Mappings []*Mapping `json:"mappings"`
+
+	// additional float32 vectors of the objects, each is indexed separately and can be searched by its name
+	NamedVectors []*NamedVector `json:"named_vectors,omitempty"`
 }
 
 // Validate validates this collection
 func (m *Collection) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMappings(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNamedVectors(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Collection) validateMappings(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Mappings) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Mappings); i++ {
+		if swag.IsZero(m.Mappings[i]) { // not required
+			continue
+		}
+
+		if m.Mappings[i] != nil {
+			if err := m.Mappings[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("mappings" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Collection) validateNamedVectors(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.NamedVectors) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.NamedVectors); i++ {
+		if swag.IsZero(m.NamedVectors[i]) { // not required
+			continue
+		}
+
+		if m.NamedVectors[i] != nil {
+			if err := m.NamedVectors[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("named_vectors" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -24,6 +24,45 @@ func init() {
     "version": "1"
   },
   "paths": {
+    "/v1/backup/{backupName}/restore": {
+      "post": {
+        "description": "Create the collection of a backup in the server's backups directory, the collection must not exist",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "collection"
+        ],
+        "summary": "Restore a collection from a backup",
+        "operationId": "restoreCollection",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "File name of the backup to restore",
+            "name": "backupName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created successfully",
+            "schema": {
+              "$ref": "#/definitions/CollectionCreated"
+            }
+          },
+          "400": {
+            "description": "Invalid backup"
+          },
+          "404": {
+            "description": "Backup not found"
+          },
+          "409": {
+            "description": "Collection already exists"
+          }
+        }
+      }
+    },
     "/v1/collection": {
       "post": {
         "description": "Add a new collection to the database",
@@ -54,6 +93,12 @@ func init() {
             "schema": {
               "$ref": "#/definitions/CollectionCreated"
             }
+          },
+          "400": {
+            "description": "Invalid collection"
+          },
+          "409": {
+            "description": "Collection already exists"
           }
         }
       }
@@ -84,6 +129,9 @@ func init() {
           },
           "400": {
             "description": "Invalid collection name"
+          },
+          "404": {
+            "description": "Collection not found"
           }
         }
       },
@@ -112,228 +160,2334 @@ func init() {
           },
           "400": {
             "description": "Invalid collection name"
-          }
-        }
-      }
-    }
-  },
-  "definitions": {
-    "ApiResponse": {
-      "type": "object",
-      "properties": {
-        "message": {
-          "type": "string",
-          "x-order": 0
-        }
-      }
-    },
-    "Collection": {
-      "type": "object",
-      "properties": {
-        "data_type": {
-          "type": "string",
-          "x-order": 3,
-          "example": "text"
-        },
-        "embedder_config": {
-          "type": "object",
-          "x-order": 5
-        },
-        "embedder_type": {
-          "type": "string",
-          "x-order": 2,
-          "example": "text2vec-huggingface"
-        },
-        "index_params": {
-          "type": "object",
-          "x-order": 4
-        },
-        "index_type": {
-          "type": "string",
-          "x-order": 1,
-          "example": "disk_ann"
-        },
-        "mappings": {
-          "type": "array",
-          "items": {
-            "format": "string"
           },
-          "x-order": 6
-        },
-        "name": {
-          "type": "string",
-          "x-order": 0,
-          "example": "movie-reviews"
+          "404": {
+            "description": "Collection not found"
+          }
         }
       }
     },
-    "CollectionCreated": {
-      "type": "object",
-      "properties": {
-        "collection_name": {
-          "type": "string",
-          "x-order": 0
-        }
-      }
-    }
-  }
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "swagger": "2.0",
-  "info": {
-    "title": "Vectory",
-    "version": "1"
-  },
-  "paths": {
-    "/v1/collection": {
+    "/v1/collection/{collectionName}/backup": {
       "post": {
-        "description": "Add a new collection to the database",
-        "consumes": [
-          "application/json"
-        ],
+        "description": "Write an archive of the collection to the server's backups directory, the collection stays online while it's written",
         "produces": [
           "application/json"
         ],
         "tags": [
           "collection"
         ],
-        "summary": "Add a collection to the database",
-        "operationId": "addCollection",
+        "summary": "Back up a collection",
+        "operationId": "backupCollection",
         "parameters": [
           {
-            "name": "collection",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Collection"
-            }
+            "type": "string",
+            "description": "Collection name to back up",
+            "name": "collectionName",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "201": {
             "description": "Created successfully",
             "schema": {
-              "$ref": "#/definitions/CollectionCreated"
+              "$ref": "#/definitions/Backup"
             }
+          },
+          "400": {
+            "description": "Invalid collection name"
+          },
+          "404": {
+            "description": "Collection not found"
           }
         }
       }
     },
-    "/v1/collection/{collectionName}": {
+    "/v1/collection/{collectionName}/objects": {
       "get": {
-        "description": "Get collection information",
+        "description": "Get objects from a collection by their ids",
+        "produces": [
+          "application/json"
+        ],
         "tags": [
-          "collection"
+          "object"
         ],
-        "summary": "Get collection information",
-        "operationId": "getCollection",
+        "summary": "Get objects from a collection",
+        "operationId": "getObjects",
         "parameters": [
           {
             "type": "string",
-            "description": "Collection name to delete",
+            "description": "Collection name to get from",
             "name": "collectionName",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "integer",
+              "format": "uint64"
+            },
+            "collectionFormat": "csv",
+            "description": "Ids of the objects to get",
+            "name": "ids",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "valid operation",
             "schema": {
-              "$ref": "#/definitions/Collection"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Object"
+              }
             }
           },
           "400": {
-            "description": "Invalid collection name"
+            "description": "Invalid collection name or ids"
+          },
+          "404": {
+            "description": "Collection not found"
           }
         }
       },
-      "delete": {
-        "description": "Delete a collection from the database",
+      "put": {
+        "description": "Update the object with the same key if exists, or insert the object to a collection otherwise",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
         "tags": [
-          "collection"
+          "object"
         ],
-        "summary": "Delete a collection from the database",
-        "operationId": "deleteCollection",
+        "summary": "Upsert an object to a collection",
+        "operationId": "upsertObject",
         "parameters": [
           {
             "type": "string",
-            "description": "Collection name to delete",
+            "description": "Collection name to upsert to",
             "name": "collectionName",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "object",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "valid operation",
+            "description": "Upserted successfully",
             "schema": {
-              "$ref": "#/definitions/ApiResponse"
+              "$ref": "#/definitions/ObjectCreated"
             }
           },
           "400": {
-            "description": "Invalid collection name"
+            "description": "Invalid object"
+          },
+          "404": {
+            "description": "Collection not found"
           }
         }
-      }
-    }
-  },
-  "definitions": {
-    "ApiResponse": {
-      "type": "object",
-      "properties": {
-        "message": {
-          "type": "string",
-          "x-order": 0
-        }
-      }
-    },
-    "Collection": {
-      "type": "object",
-      "properties": {
-        "data_type": {
-          "type": "string",
-          "x-order": 3,
-          "example": "text"
-        },
-        "embedder_config": {
-          "type": "object",
-          "x-order": 5
-        },
-        "embedder_type": {
-          "type": "string",
-          "x-order": 2,
-          "example": "text2vec-huggingface"
-        },
-        "index_params": {
-          "type": "object",
-          "x-order": 4
-        },
-        "index_type": {
-          "type": "string",
-          "x-order": 1,
-          "example": "disk_ann"
-        },
-        "mappings": {
-          "type": "array",
-          "items": {
-            "format": "string"
+      },
+      "post": {
+        "description": "Insert a new object to a collection, the object is embedded if no vector is provided",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "object"
+        ],
+        "summary": "Insert an object to a collection",
+        "operationId": "insertObject",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Collection name to insert to",
+            "name": "collectionName",
+            "in": "path",
+            "required": true
           },
-          "x-order": 6
-        },
-        "name": {
-          "type": "string",
-          "x-order": 0,
-          "example": "movie-reviews"
+          {
+            "name": "object",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created successfully",
+            "schema": {
+              "$ref": "#/definitions/ObjectCreated"
+            }
+          },
+          "400": {
+            "description": "Invalid object"
+          },
+          "404": {
+            "description": "Collection not found"
+          }
         }
       }
     },
-    "CollectionCreated": {
-      "type": "object",
-      "properties": {
-        "collection_name": {
-          "type": "string",
-          "x-order": 0
+    "/v1/collection/{collectionName}/objects/batch": {
+      "post": {
+        "description": "Insert a batch of objects to a collection, objects are embedded if no vector is provided. An object that is invalid or fails to be inserted doesn't fail the others, the outcome of each object is returned in the batch's order.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "object"
+        ],
+        "summary": "Insert a batch of objects to a collection",
+        "operationId": "insertObjectsBatch",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Collection name to insert to",
+            "name": "collectionName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "objects",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Object"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created successfully",
+            "schema": {
+              "$ref": "#/definitions/ObjectsBatchCreated"
+            }
+          },
+          "207": {
+            "description": "Some or all of the objects were not inserted",
+            "schema": {
+              "$ref": "#/definitions/ObjectsBatchCreated"
+            }
+          },
+          "400": {
+            "description": "Invalid objects"
+          },
+          "404": {
+            "description": "Collection not found"
+          }
+        }
+      }
+    },
+    "/v1/collection/{collectionName}/objects/import": {
+      "post": {
+        "description": "Insert the objects of JSON lines to a collection in batches, one object per line, the objects are embedded if no vector is provided. Records that can't be decoded or inserted don't fail the others, an interrupted import is resumed by skipping the records it handled.",
+        "consumes": [
+          "application/octet-stream"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "object"
+        ],
+        "summary": "Import objects to a collection",
+        "operationId": "importObjects",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Collection name to import to",
+            "name": "collectionName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "JSON lines of the objects to import",
+            "name": "records",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Field of the objects' keys, key when empty",
+            "name": "key",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Field of the objects' vectors, vector when empty",
+            "name": "vector",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma separated name=field of the objects' named vectors, the vectors object when empty",
+            "name": "vectors",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma separated fields of the objects' properties, the properties object when empty",
+            "name": "properties",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Number of records inserted in each batch",
+            "name": "batch_size",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Number of records skipped at the start of the input",
+            "name": "skip",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Imported successfully",
+            "schema": {
+              "$ref": "#/definitions/ImportResult"
+            }
+          },
+          "400": {
+            "description": "Invalid mapping or records"
+          },
+          "404": {
+            "description": "Collection not found"
+          }
+        }
+      }
+    },
+    "/v1/collection/{collectionName}/objects/keys/{objectKey}": {
+      "get": {
+        "description": "Get an object from a collection by its caller-provided key",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "object"
+        ],
+        "summary": "Get an object from a collection by its key",
+        "operationId": "getObjectByKey",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Collection name to get from",
+            "name": "collectionName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Object key to get",
+            "name": "objectKey",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "valid operation",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "400": {
+            "description": "Invalid collection name"
+          },
+          "404": {
+            "description": "Collection or object not found"
+          }
+        }
+      },
+      "delete": {
+        "description": "Delete an object from a collection by its caller-provided key",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "object"
+        ],
+        "summary": "Delete an object from a collection by its key",
+        "operationId": "deleteObjectByKey",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Collection name to delete from",
+            "name": "collectionName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Object key to delete",
+            "name": "objectKey",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "valid operation",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid collection name"
+          },
+          "404": {
+            "description": "Collection not found"
+          }
+        }
+      }
+    },
+    "/v1/collection/{collectionName}/objects/{objectId}": {
+      "delete": {
+        "description": "Delete an object from a collection",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "object"
+        ],
+        "summary": "Delete an object from a collection",
+        "operationId": "deleteObject",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Collection name to delete from",
+            "name": "collectionName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "uint64",
+            "description": "Object id to delete",
+            "name": "objectId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "valid operation",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid collection name or object id"
+          },
+          "404": {
+            "description": "Collection or object not found"
+          }
+        }
+      }
+    },
+    "/v1/collection/{collectionName}/range_search": {
+      "post": {
+        "description": "Return the objects of a collection within a distance of the query sorted by their distance",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "object"
+        ],
+        "summary": "Range search a collection",
+        "operationId": "rangeSearch",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Collection name to search in",
+            "name": "collectionName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "query",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/RangeQuery"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "valid operation",
+            "schema": {
+              "$ref": "#/definitions/SearchResult"
+            }
+          },
+          "400": {
+            "description": "Invalid query"
+          },
+          "404": {
+            "description": "Collection not found"
+          }
+        }
+      }
+    },
+    "/v1/collection/{collectionName}/search": {
+      "post": {
+        "description": "Perform a semantic search over a collection and return the approximate k nearest objects",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "object"
+        ],
+        "summary": "Search a collection",
+        "operationId": "semanticSearch",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Collection name to search in",
+            "name": "collectionName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "query",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SearchQuery"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "valid operation",
+            "schema": {
+              "$ref": "#/definitions/SearchResult"
+            }
+          },
+          "400": {
+            "description": "Invalid query"
+          },
+          "404": {
+            "description": "Collection not found"
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "ApiResponse": {
+      "type": "object",
+      "properties": {
+        "message": {
+          "type": "string",
+          "x-order": 0
+        }
+      }
+    },
+    "Backup": {
+      "type": "object",
+      "properties": {
+        "collection": {
+          "type": "string",
+          "x-order": 1
+        },
+        "name": {
+          "description": "File name of the backup in the server's backups directory",
+          "type": "string",
+          "x-order": 0
+        }
+      }
+    },
+    "BatchObjectResult": {
+      "type": "object",
+      "properties": {
+        "error": {
+          "description": "reason the object was not inserted",
+          "type": "string",
+          "x-order": 1
+        },
+        "id": {
+          "description": "id assigned to the object, set only when it was inserted",
+          "type": "integer",
+          "format": "uint64",
+          "x-order": 0
+        }
+      }
+    },
+    "Collection": {
+      "type": "object",
+      "properties": {
+        "data_type": {
+          "type": "string",
+          "x-order": 3,
+          "example": "text"
+        },
+        "embedder_config": {
+          "type": "object",
+          "x-order": 6
+        },
+        "embedder_type": {
+          "type": "string",
+          "x-order": 2,
+          "example": "text2vec-huggingface"
+        },
+        "index_params": {
+          "type": "object",
+          "x-order": 5
+        },
+        "index_type": {
+          "type": "string",
+          "x-order": 1,
+          "example": "disk_ann"
+        },
+        "mappings": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Mapping"
+          },
+          "x-order": 7
+        },
+        "name": {
+          "type": "string",
+          "x-order": 0,
+          "example": "movie-reviews"
+        },
+        "named_vectors": {
+          "description": "additional float32 vectors of the objects, each is indexed separately and can be searched by its name",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NamedVector"
+          },
+          "x-omitempty": true,
+          "x-order": 8
+        },
+        "vector_type": {
+          "description": "one of float32, binary. binary vectors hold only 0 and 1 and require the hamming distance",
+          "type": "string",
+          "x-order": 4,
+          "example": "float32"
+        }
+      }
+    },
+    "CollectionCreated": {
+      "type": "object",
+      "properties": {
+        "collection_name": {
+          "type": "string",
+          "x-order": 0
+        }
+      }
+    },
+    "HybridSearch": {
+      "description": "fuses the k nearest objects with the BM25 keyword results of the collection's indexed properties",
+      "type": "object",
+      "properties": {
+        "alpha": {
+          "description": "weight of the vector scores in weighted fusion, 0 is a pure keyword search and 1 a pure vector search",
+          "type": "number",
+          "format": "float",
+          "x-order": 2,
+          "example": 0.5
+        },
+        "fusion": {
+          "description": "one of rrf, weighted",
+          "type": "string",
+          "x-order": 1,
+          "example": "rrf"
+        },
+        "query": {
+          "description": "keyword query, the text of the searched object's indexed properties when empty",
+          "type": "string",
+          "x-order": 0,
+          "example": "Chianti Classico"
+        }
+      }
+    },
+    "ImportError": {
+      "type": "object",
+      "properties": {
+        "error": {
+          "description": "reason the record was not inserted",
+          "type": "string",
+          "x-order": 1
+        },
+        "record": {
+          "description": "number of the record in the input, from 0",
+          "type": "integer",
+          "x-omitempty": false,
+          "x-order": 0
+        }
+      }
+    },
+    "ImportResult": {
+      "type": "object",
+      "properties": {
+        "errors": {
+          "description": "errors of the first failed records",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ImportError"
+          },
+          "x-order": 3
+        },
+        "failed": {
+          "description": "number of records that were not inserted",
+          "type": "integer",
+          "x-omitempty": false,
+          "x-order": 2
+        },
+        "imported": {
+          "description": "number of inserted objects",
+          "type": "integer",
+          "x-omitempty": false,
+          "x-order": 1
+        },
+        "records": {
+          "description": "number of records read including the skipped ones, all of them were either imported or failed",
+          "type": "integer",
+          "x-omitempty": false,
+          "x-order": 0
+        }
+      }
+    },
+    "Mapping": {
+      "type": "object",
+      "properties": {
+        "embed": {
+          "description": "whether the property is part of the embedder's input, collections with an embedder need at least one",
+          "type": "boolean",
+          "x-omitempty": false,
+          "x-order": 4
+        },
+        "filterable": {
+          "description": "whether the property can be used in search filters",
+          "type": "boolean",
+          "x-omitempty": false,
+          "x-order": 3
+        },
+        "indexed": {
+          "description": "whether the property is indexed for keyword search",
+          "type": "boolean",
+          "x-omitempty": false,
+          "x-order": 2
+        },
+        "name": {
+          "type": "string",
+          "x-order": 0,
+          "example": "title"
+        },
+        "type": {
+          "description": "one of text, int, float, bool, date, string_array, geo_point",
+          "type": "string",
+          "x-order": 1,
+          "example": "text"
+        }
+      }
+    },
+    "NamedVector": {
+      "type": "object",
+      "properties": {
+        "index_params": {
+          "description": "params of the index, which also set the distance type of the vector",
+          "type": "object",
+          "x-order": 2
+        },
+        "index_type": {
+          "type": "string",
+          "x-order": 1,
+          "example": "hnsw"
+        },
+        "name": {
+          "type": "string",
+          "x-order": 0,
+          "example": "title"
+        }
+      }
+    },
+    "Object": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "integer",
+          "format": "uint64",
+          "x-omitempty": false,
+          "x-order": 0
+        },
+        "key": {
+          "description": "optional caller-provided unique key, e.g. a document key or a UUID",
+          "type": "string",
+          "x-order": 1,
+          "example": "3f2b8c1e-5d4a-4e7b-9c2d-1a6f0e8b7d35"
+        },
+        "properties": {
+          "additionalProperties": true,
+          "type": "object",
+          "x-order": 2
+        },
+        "vector": {
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float"
+          },
+          "x-order": 3
+        },
+        "vectors": {
+          "description": "named vectors of the collection",
+          "type": "object",
+          "additionalProperties": {
+            "type": "array",
+            "items": {
+              "type": "number",
+              "format": "float"
+            }
+          },
+          "x-order": 4
+        }
+      }
+    },
+    "ObjectCreated": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "integer",
+          "format": "uint64",
+          "x-omitempty": false,
+          "x-order": 0
+        }
+      }
+    },
+    "ObjectWithDistance": {
+      "type": "object",
+      "properties": {
+        "distance": {
+          "description": "returned unless include_distance is false",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true,
+          "x-order": 5
+        },
+        "id": {
+          "type": "integer",
+          "format": "uint64",
+          "x-omitempty": false,
+          "x-order": 0
+        },
+        "key": {
+          "type": "string",
+          "x-order": 1
+        },
+        "properties": {
+          "additionalProperties": true,
+          "type": "object",
+          "x-order": 2
+        },
+        "score": {
+          "description": "fused score of hybrid searches, higher is better",
+          "type": "number",
+          "format": "float",
+          "x-order": 6
+        },
+        "vector": {
+          "description": "returned when include_vector is set",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float"
+          },
+          "x-omitempty": true,
+          "x-order": 3
+        },
+        "vectors": {
+          "description": "named vectors, returned when include_vector is set",
+          "type": "object",
+          "additionalProperties": {
+            "type": "array",
+            "items": {
+              "type": "number",
+              "format": "float"
+            }
+          },
+          "x-order": 4
+        }
+      }
+    },
+    "ObjectsBatchCreated": {
+      "type": "object",
+      "properties": {
+        "failed": {
+          "description": "number of objects that were not inserted",
+          "type": "integer",
+          "x-omitempty": false,
+          "x-order": 2
+        },
+        "ids": {
+          "description": "ids of the inserted objects",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "uint64"
+          },
+          "x-order": 0
+        },
+        "partial": {
+          "description": "whether only some of the objects were inserted",
+          "type": "boolean",
+          "x-omitempty": false,
+          "x-order": 3
+        },
+        "results": {
+          "description": "outcome of each object in the batch's order",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BatchObjectResult"
+          },
+          "x-order": 1
+        }
+      }
+    },
+    "RangeQuery": {
+      "type": "object",
+      "required": [
+        "radius"
+      ],
+      "properties": {
+        "limit": {
+          "description": "maximum number of returned objects, 1000 when 0",
+          "type": "integer",
+          "x-order": 5,
+          "example": 100
+        },
+        "properties": {
+          "additionalProperties": true,
+          "type": "object",
+          "x-order": 0
+        },
+        "radius": {
+          "description": "maximum distance of the returned objects",
+          "type": "number",
+          "format": "float",
+          "x-order": 4,
+          "example": 0.1
+        },
+        "vector": {
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float"
+          },
+          "x-order": 1
+        },
+        "vector_name": {
+          "description": "named vector to search, the objects' vector when empty",
+          "type": "string",
+          "x-order": 3,
+          "example": "title"
+        },
+        "vectors": {
+          "description": "named vectors of the searched object",
+          "type": "object",
+          "additionalProperties": {
+            "type": "array",
+            "items": {
+              "type": "number",
+              "format": "float"
+            }
+          },
+          "x-order": 2
+        }
+      }
+    },
+    "SearchFilter": {
+      "type": "object",
+      "properties": {
+        "operands": {
+          "description": "operands of the and, or, not operators",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SearchFilter"
+          },
+          "x-order": 3
+        },
+        "operator": {
+          "description": "one of eq, neq, lt, lte, gt, gte, in, and, or, not",
+          "type": "string",
+          "x-order": 0,
+          "example": "eq"
+        },
+        "property": {
+          "description": "property to compare, used by comparison operators",
+          "type": "string",
+          "x-order": 1,
+          "example": "country"
+        },
+        "value": {
+          "description": "value to compare with, a list of values for the in operator",
+          "x-order": 2,
+          "example": "Italy"
+        }
+      }
+    },
+    "SearchQuery": {
+      "type": "object",
+      "properties": {
+        "ef": {
+          "description": "size of the candidates list, which trades latency for recall. the index's ef or list size when 0",
+          "type": "integer",
+          "x-order": 5,
+          "example": 100
+        },
+        "filter": {
+          "x-order": 9,
+          "$ref": "#/definitions/SearchFilter"
+        },
+        "hybrid": {
+          "x-order": 10,
+          "$ref": "#/definitions/HybridSearch"
+        },
+        "include_distance": {
+          "description": "whether to return the distances of the objects, true when absent",
+          "type": "boolean",
+          "x-nullable": true,
+          "x-order": 8
+        },
+        "include_vector": {
+          "description": "whether to return the vectors of the objects",
+          "type": "boolean",
+          "x-order": 7
+        },
+        "k": {
+          "type": "integer",
+          "x-order": 4,
+          "example": 10
+        },
+        "max_distance": {
+          "description": "objects farther than it are not returned",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true,
+          "x-order": 6
+        },
+        "properties": {
+          "additionalProperties": true,
+          "type": "object",
+          "x-order": 0
+        },
+        "vector": {
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float"
+          },
+          "x-order": 1
+        },
+        "vector_name": {
+          "description": "named vector to search, the objects' vector when empty",
+          "type": "string",
+          "x-order": 3,
+          "example": "title"
+        },
+        "vectors": {
+          "description": "named vectors of the searched object",
+          "type": "object",
+          "additionalProperties": {
+            "type": "array",
+            "items": {
+              "type": "number",
+              "format": "float"
+            }
+          },
+          "x-order": 2
+        }
+      }
+    },
+    "SearchResult": {
+      "type": "object",
+      "properties": {
+        "hits": {
+          "type": "integer",
+          "x-order": 0
+        },
+        "objects": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ObjectWithDistance"
+          },
+          "x-order": 1
+        }
+      }
+    }
+  }
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "swagger": "2.0",
+  "info": {
+    "title": "Vectory",
+    "version": "1"
+  },
+  "paths": {
+    "/v1/backup/{backupName}/restore": {
+      "post": {
+        "description": "Create the collection of a backup in the server's backups directory, the collection must not exist",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "collection"
+        ],
+        "summary": "Restore a collection from a backup",
+        "operationId": "restoreCollection",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "File name of the backup to restore",
+            "name": "backupName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created successfully",
+            "schema": {
+              "$ref": "#/definitions/CollectionCreated"
+            }
+          },
+          "400": {
+            "description": "Invalid backup"
+          },
+          "404": {
+            "description": "Backup not found"
+          },
+          "409": {
+            "description": "Collection already exists"
+          }
+        }
+      }
+    },
+    "/v1/collection": {
+      "post": {
+        "description": "Add a new collection to the database",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "collection"
+        ],
+        "summary": "Add a collection to the database",
+        "operationId": "addCollection",
+        "parameters": [
+          {
+            "name": "collection",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Collection"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created successfully",
+            "schema": {
+              "$ref": "#/definitions/CollectionCreated"
+            }
+          },
+          "400": {
+            "description": "Invalid collection"
+          },
+          "409": {
+            "description": "Collection already exists"
+          }
+        }
+      }
+    },
+    "/v1/collection/{collectionName}": {
+      "get": {
+        "description": "Get collection information",
+        "tags": [
+          "collection"
+        ],
+        "summary": "Get collection information",
+        "operationId": "getCollection",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Collection name to delete",
+            "name": "collectionName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "valid operation",
+            "schema": {
+              "$ref": "#/definitions/Collection"
+            }
+          },
+          "400": {
+            "description": "Invalid collection name"
+          },
+          "404": {
+            "description": "Collection not found"
+          }
+        }
+      },
+      "delete": {
+        "description": "Delete a collection from the database",
+        "tags": [
+          "collection"
+        ],
+        "summary": "Delete a collection from the database",
+        "operationId": "deleteCollection",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Collection name to delete",
+            "name": "collectionName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "valid operation",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid collection name"
+          },
+          "404": {
+            "description": "Collection not found"
+          }
+        }
+      }
+    },
+    "/v1/collection/{collectionName}/backup": {
+      "post": {
+        "description": "Write an archive of the collection to the server's backups directory, the collection stays online while it's written",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "collection"
+        ],
+        "summary": "Back up a collection",
+        "operationId": "backupCollection",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Collection name to back up",
+            "name": "collectionName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created successfully",
+            "schema": {
+              "$ref": "#/definitions/Backup"
+            }
+          },
+          "400": {
+            "description": "Invalid collection name"
+          },
+          "404": {
+            "description": "Collection not found"
+          }
+        }
+      }
+    },
+    "/v1/collection/{collectionName}/objects": {
+      "get": {
+        "description": "Get objects from a collection by their ids",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "object"
+        ],
+        "summary": "Get objects from a collection",
+        "operationId": "getObjects",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Collection name to get from",
+            "name": "collectionName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "integer",
+              "format": "uint64"
+            },
+            "collectionFormat": "csv",
+            "description": "Ids of the objects to get",
+            "name": "ids",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "valid operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Object"
+              }
+            }
+          },
+          "400": {
+            "description": "Invalid collection name or ids"
+          },
+          "404": {
+            "description": "Collection not found"
+          }
+        }
+      },
+      "put": {
+        "description": "Update the object with the same key if exists, or insert the object to a collection otherwise",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "object"
+        ],
+        "summary": "Upsert an object to a collection",
+        "operationId": "upsertObject",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Collection name to upsert to",
+            "name": "collectionName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "object",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Upserted successfully",
+            "schema": {
+              "$ref": "#/definitions/ObjectCreated"
+            }
+          },
+          "400": {
+            "description": "Invalid object"
+          },
+          "404": {
+            "description": "Collection not found"
+          }
+        }
+      },
+      "post": {
+        "description": "Insert a new object to a collection, the object is embedded if no vector is provided",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "object"
+        ],
+        "summary": "Insert an object to a collection",
+        "operationId": "insertObject",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Collection name to insert to",
+            "name": "collectionName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "object",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created successfully",
+            "schema": {
+              "$ref": "#/definitions/ObjectCreated"
+            }
+          },
+          "400": {
+            "description": "Invalid object"
+          },
+          "404": {
+            "description": "Collection not found"
+          }
+        }
+      }
+    },
+    "/v1/collection/{collectionName}/objects/batch": {
+      "post": {
+        "description": "Insert a batch of objects to a collection, objects are embedded if no vector is provided. An object that is invalid or fails to be inserted doesn't fail the others, the outcome of each object is returned in the batch's order.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "object"
+        ],
+        "summary": "Insert a batch of objects to a collection",
+        "operationId": "insertObjectsBatch",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Collection name to insert to",
+            "name": "collectionName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "objects",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Object"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created successfully",
+            "schema": {
+              "$ref": "#/definitions/ObjectsBatchCreated"
+            }
+          },
+          "207": {
+            "description": "Some or all of the objects were not inserted",
+            "schema": {
+              "$ref": "#/definitions/ObjectsBatchCreated"
+            }
+          },
+          "400": {
+            "description": "Invalid objects"
+          },
+          "404": {
+            "description": "Collection not found"
+          }
+        }
+      }
+    },
+    "/v1/collection/{collectionName}/objects/import": {
+      "post": {
+        "description": "Insert the objects of JSON lines to a collection in batches, one object per line, the objects are embedded if no vector is provided. Records that can't be decoded or inserted don't fail the others, an interrupted import is resumed by skipping the records it handled.",
+        "consumes": [
+          "application/octet-stream"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "object"
+        ],
+        "summary": "Import objects to a collection",
+        "operationId": "importObjects",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Collection name to import to",
+            "name": "collectionName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "JSON lines of the objects to import",
+            "name": "records",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Field of the objects' keys, key when empty",
+            "name": "key",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Field of the objects' vectors, vector when empty",
+            "name": "vector",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma separated name=field of the objects' named vectors, the vectors object when empty",
+            "name": "vectors",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma separated fields of the objects' properties, the properties object when empty",
+            "name": "properties",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Number of records inserted in each batch",
+            "name": "batch_size",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Number of records skipped at the start of the input",
+            "name": "skip",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Imported successfully",
+            "schema": {
+              "$ref": "#/definitions/ImportResult"
+            }
+          },
+          "400": {
+            "description": "Invalid mapping or records"
+          },
+          "404": {
+            "description": "Collection not found"
+          }
+        }
+      }
+    },
+    "/v1/collection/{collectionName}/objects/keys/{objectKey}": {
+      "get": {
+        "description": "Get an object from a collection by its caller-provided key",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "object"
+        ],
+        "summary": "Get an object from a collection by its key",
+        "operationId": "getObjectByKey",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Collection name to get from",
+            "name": "collectionName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Object key to get",
+            "name": "objectKey",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "valid operation",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "400": {
+            "description": "Invalid collection name"
+          },
+          "404": {
+            "description": "Collection or object not found"
+          }
+        }
+      },
+      "delete": {
+        "description": "Delete an object from a collection by its caller-provided key",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "object"
+        ],
+        "summary": "Delete an object from a collection by its key",
+        "operationId": "deleteObjectByKey",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Collection name to delete from",
+            "name": "collectionName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Object key to delete",
+            "name": "objectKey",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "valid operation",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid collection name"
+          },
+          "404": {
+            "description": "Collection not found"
+          }
+        }
+      }
+    },
+    "/v1/collection/{collectionName}/objects/{objectId}": {
+      "delete": {
+        "description": "Delete an object from a collection",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "object"
+        ],
+        "summary": "Delete an object from a collection",
+        "operationId": "deleteObject",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Collection name to delete from",
+            "name": "collectionName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "uint64",
+            "description": "Object id to delete",
+            "name": "objectId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "valid operation",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid collection name or object id"
+          },
+          "404": {
+            "description": "Collection or object not found"
+          }
+        }
+      }
+    },
+    "/v1/collection/{collectionName}/range_search": {
+      "post": {
+        "description": "Return the objects of a collection within a distance of the query sorted by their distance",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "object"
+        ],
+        "summary": "Range search a collection",
+        "operationId": "rangeSearch",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Collection name to search in",
+            "name": "collectionName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "query",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/RangeQuery"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "valid operation",
+            "schema": {
+              "$ref": "#/definitions/SearchResult"
+            }
+          },
+          "400": {
+            "description": "Invalid query"
+          },
+          "404": {
+            "description": "Collection not found"
+          }
+        }
+      }
+    },
+    "/v1/collection/{collectionName}/search": {
+      "post": {
+        "description": "Perform a semantic search over a collection and return the approximate k nearest objects",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "object"
+        ],
+        "summary": "Search a collection",
+        "operationId": "semanticSearch",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Collection name to search in",
+            "name": "collectionName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "query",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SearchQuery"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "valid operation",
+            "schema": {
+              "$ref": "#/definitions/SearchResult"
+            }
+          },
+          "400": {
+            "description": "Invalid query"
+          },
+          "404": {
+            "description": "Collection not found"
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "ApiResponse": {
+      "type": "object",
+      "properties": {
+        "message": {
+          "type": "string",
+          "x-order": 0
+        }
+      }
+    },
+    "Backup": {
+      "type": "object",
+      "properties": {
+        "collection": {
+          "type": "string",
+          "x-order": 1
+        },
+        "name": {
+          "description": "File name of the backup in the server's backups directory",
+          "type": "string",
+          "x-order": 0
+        }
+      }
+    },
+    "BatchObjectResult": {
+      "type": "object",
+      "properties": {
+        "error": {
+          "description": "reason the object was not inserted",
+          "type": "string",
+          "x-order": 1
+        },
+        "id": {
+          "description": "id assigned to the object, set only when it was inserted",
+          "type": "integer",
+          "format": "uint64",
+          "x-order": 0
+        }
+      }
+    },
+    "Collection": {
+      "type": "object",
+      "properties": {
+        "data_type": {
+          "type": "string",
+          "x-order": 3,
+          "example": "text"
+        },
+        "embedder_config": {
+          "type": "object",
+          "x-order": 6
+        },
+        "embedder_type": {
+          "type": "string",
+          "x-order": 2,
+          "example": "text2vec-huggingface"
+        },
+        "index_params": {
+          "type": "object",
+          "x-order": 5
+        },
+        "index_type": {
+          "type": "string",
+          "x-order": 1,
+          "example": "disk_ann"
+        },
+        "mappings": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Mapping"
+          },
+          "x-order": 7
+        },
+        "name": {
+          "type": "string",
+          "x-order": 0,
+          "example": "movie-reviews"
+        },
+        "named_vectors": {
+          "description": "additional float32 vectors of the objects, each is indexed separately and can be searched by its name",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NamedVector"
+          },
+          "x-omitempty": true,
+          "x-order": 8
+        },
+        "vector_type": {
+          "description": "one of float32, binary. binary vectors hold only 0 and 1 and require the hamming distance",
+          "type": "string",
+          "x-order": 4,
+          "example": "float32"
+        }
+      }
+    },
+    "CollectionCreated": {
+      "type": "object",
+      "properties": {
+        "collection_name": {
+          "type": "string",
+          "x-order": 0
+        }
+      }
+    },
+    "HybridSearch": {
+      "description": "fuses the k nearest objects with the BM25 keyword results of the collection's indexed properties",
+      "type": "object",
+      "properties": {
+        "alpha": {
+          "description": "weight of the vector scores in weighted fusion, 0 is a pure keyword search and 1 a pure vector search",
+          "type": "number",
+          "format": "float",
+          "x-order": 2,
+          "example": 0.5
+        },
+        "fusion": {
+          "description": "one of rrf, weighted",
+          "type": "string",
+          "x-order": 1,
+          "example": "rrf"
+        },
+        "query": {
+          "description": "keyword query, the text of the searched object's indexed properties when empty",
+          "type": "string",
+          "x-order": 0,
+          "example": "Chianti Classico"
+        }
+      }
+    },
+    "ImportError": {
+      "type": "object",
+      "properties": {
+        "error": {
+          "description": "reason the record was not inserted",
+          "type": "string",
+          "x-order": 1
+        },
+        "record": {
+          "description": "number of the record in the input, from 0",
+          "type": "integer",
+          "x-omitempty": false,
+          "x-order": 0
+        }
+      }
+    },
+    "ImportResult": {
+      "type": "object",
+      "properties": {
+        "errors": {
+          "description": "errors of the first failed records",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ImportError"
+          },
+          "x-order": 3
+        },
+        "failed": {
+          "description": "number of records that were not inserted",
+          "type": "integer",
+          "x-omitempty": false,
+          "x-order": 2
+        },
+        "imported": {
+          "description": "number of inserted objects",
+          "type": "integer",
+          "x-omitempty": false,
+          "x-order": 1
+        },
+        "records": {
+          "description": "number of records read including the skipped ones, all of them were either imported or failed",
+          "type": "integer",
+          "x-omitempty": false,
+          "x-order": 0
+        }
+      }
+    },
+    "Mapping": {
+      "type": "object",
+      "properties": {
+        "embed": {
+          "description": "whether the property is part of the embedder's input, collections with an embedder need at least one",
+          "type": "boolean",
+          "x-omitempty": false,
+          "x-order": 4
+        },
+        "filterable": {
+          "description": "whether the property can be used in search filters",
+          "type": "boolean",
+          "x-omitempty": false,
+          "x-order": 3
+        },
+        "indexed": {
+          "description": "whether the property is indexed for keyword search",
+          "type": "boolean",
+          "x-omitempty": false,
+          "x-order": 2
+        },
+        "name": {
+          "type": "string",
+          "x-order": 0,
+          "example": "title"
+        },
+        "type": {
+          "description": "one of text, int, float, bool, date, string_array, geo_point",
+          "type": "string",
+          "x-order": 1,
+          "example": "text"
+        }
+      }
+    },
+    "NamedVector": {
+      "type": "object",
+      "properties": {
+        "index_params": {
+          "description": "params of the index, which also set the distance type of the vector",
+          "type": "object",
+          "x-order": 2
+        },
+        "index_type": {
+          "type": "string",
+          "x-order": 1,
+          "example": "hnsw"
+        },
+        "name": {
+          "type": "string",
+          "x-order": 0,
+          "example": "title"
+        }
+      }
+    },
+    "Object": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "integer",
+          "format": "uint64",
+          "x-omitempty": false,
+          "x-order": 0
+        },
+        "key": {
+          "description": "optional caller-provided unique key, e.g. a document key or a UUID",
+          "type": "string",
+          "x-order": 1,
+          "example": "3f2b8c1e-5d4a-4e7b-9c2d-1a6f0e8b7d35"
+        },
+        "properties": {
+          "additionalProperties": true,
+          "type": "object",
+          "x-order": 2
+        },
+        "vector": {
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float"
+          },
+          "x-order": 3
+        },
+        "vectors": {
+          "description": "named vectors of the collection",
+          "type": "object",
+          "additionalProperties": {
+            "type": "array",
+            "items": {
+              "type": "number",
+              "format": "float"
+            }
+          },
+          "x-order": 4
+        }
+      }
+    },
+    "ObjectCreated": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "integer",
+          "format": "uint64",
+          "x-omitempty": false,
+          "x-order": 0
+        }
+      }
+    },
+    "ObjectWithDistance": {
+      "type": "object",
+      "properties": {
+        "distance": {
+          "description": "returned unless include_distance is false",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true,
+          "x-order": 5
+        },
+        "id": {
+          "type": "integer",
+          "format": "uint64",
+          "x-omitempty": false,
+          "x-order": 0
+        },
+        "key": {
+          "type": "string",
+          "x-order": 1
+        },
+        "properties": {
+          "additionalProperties": true,
+          "type": "object",
+          "x-order": 2
+        },
+        "score": {
+          "description": "fused score of hybrid searches, higher is better",
+          "type": "number",
+          "format": "float",
+          "x-order": 6
+        },
+        "vector": {
+          "description": "returned when include_vector is set",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float"
+          },
+          "x-omitempty": true,
+          "x-order": 3
+        },
+        "vectors": {
+          "description": "named vectors, returned when include_vector is set",
+          "type": "object",
+          "additionalProperties": {
+            "type": "array",
+            "items": {
+              "type": "number",
+              "format": "float"
+            }
+          },
+          "x-order": 4
+        }
+      }
+    },
+    "ObjectsBatchCreated": {
+      "type": "object",
+      "properties": {
+        "failed": {
+          "description": "number of objects that were not inserted",
+          "type": "integer",
+          "x-omitempty": false,
+          "x-order": 2
+        },
+        "ids": {
+          "description": "ids of the inserted objects",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "uint64"
+          },
+          "x-order": 0
+        },
+        "partial": {
+          "description": "whether only some of the objects were inserted",
+          "type": "boolean",
+          "x-omitempty": false,
+          "x-order": 3
+        },
+        "results": {
+          "description": "outcome of each object in the batch's order",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BatchObjectResult"
+          },
+          "x-order": 1
+        }
+      }
+    },
+    "RangeQuery": {
+      "type": "object",
+      "required": [
+        "radius"
+      ],
+      "properties": {
+        "limit": {
+          "description": "maximum number of returned objects, 1000 when 0",
+          "type": "integer",
+          "x-order": 5,
+          "example": 100
+        },
+        "properties": {
+          "additionalProperties": true,
+          "type": "object",
+          "x-order": 0
+        },
+        "radius": {
+          "description": "maximum distance of the returned objects",
+          "type": "number",
+          "format": "float",
+          "x-order": 4,
+          "example": 0.1
+        },
+        "vector": {
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float"
+          },
+          "x-order": 1
+        },
+        "vector_name": {
+          "description": "named vector to search, the objects' vector when empty",
+          "type": "string",
+          "x-order": 3,
+          "example": "title"
+        },
+        "vectors": {
+          "description": "named vectors of the searched object",
+          "type": "object",
+          "additionalProperties": {
+            "type": "array",
+            "items": {
+              "type": "number",
+              "format": "float"
+            }
+          },
+          "x-order": 2
+        }
+      }
+    },
+    "SearchFilter": {
+      "type": "object",
+      "properties": {
+        "operands": {
+          "description": "operands of the and, or, not operators",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SearchFilter"
+          },
+          "x-order": 3
+        },
+        "operator": {
+          "description": "one of eq, neq, lt, lte, gt, gte, in, and, or, not",
+          "type": "string",
+          "x-order": 0,
+          "example": "eq"
+        },
+        "property": {
+          "description": "property to compare, used by comparison operators",
+          "type": "string",
+          "x-order": 1,
+          "example": "country"
+        },
+        "value": {
+          "description": "value to compare with, a list of values for the in operator",
+          "x-order": 2,
+          "example": "Italy"
+        }
+      }
+    },
+    "SearchQuery": {
+      "type": "object",
+      "properties": {
+        "ef": {
+          "description": "size of the candidates list, which trades latency for recall. the index's ef or list size when 0",
+          "type": "integer",
+          "x-order": 5,
+          "example": 100
+        },
+        "filter": {
+          "x-order": 9,
+          "$ref": "#/definitions/SearchFilter"
+        },
+        "hybrid": {
+          "x-order": 10,
+          "$ref": "#/definitions/HybridSearch"
+        },
+        "include_distance": {
+          "description": "whether to return the distances of the objects, true when absent",
+          "type": "boolean",
+          "x-nullable": true,
+          "x-order": 8
+        },
+        "include_vector": {
+          "description": "whether to return the vectors of the objects",
+          "type": "boolean",
+          "x-order": 7
+        },
+        "k": {
+          "type": "integer",
+          "x-order": 4,
+          "example": 10
+        },
+        "max_distance": {
+          "description": "objects farther than it are not returned",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true,
+          "x-order": 6
+        },
+        "properties": {
+          "additionalProperties": true,
+          "type": "object",
+          "x-order": 0
+        },
+        "vector": {
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float"
+          },
+          "x-order": 1
+        },
+        "vector_name": {
+          "description": "named vector to search, the objects' vector when empty",
+          "type": "string",
+          "x-order": 3,
+          "example": "title"
+        },
+        "vectors": {
+          "description": "named vectors of the searched object",
+          "type": "object",
+          "additionalProperties": {
+            "type": "array",
+            "items": {
+              "type": "number",
+              "format": "float"
+            }
+          },
+          "x-order": 2
+        }
+      }
+    },
+    "SearchResult": {
+      "type": "object",
+      "properties": {
+        "hits": {
+          "type": "integer",
+          "x-order": 0
+        },
+        "objects": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ObjectWithDistance"
+          },
+          "x-order": 1
         }
       }
     }
@@ -56,3 +56,51 @@ func (o *AddCollectionCreated) WriteResponse(rw http.ResponseWriter, producer ru
 		}
 	}
 }
+
+// AddCollectionBadRequestCode is the HTTP code returned for type AddCollectionBadRequest
+const AddCollectionBadRequestCode int = 400
+
+/*AddCollectionBadRequest Invalid collection
+
+swagger:response addCollectionBadRequest
+*/
+type AddCollectionBadRequest struct {
+}
+
+// NewAddCollectionBadRequest creates AddCollectionBadRequest with default headers values
+func NewAddCollectionBadRequest() *AddCollectionBadRequest {
+
+	return &AddCollectionBadRequest{}
+}
+
+// WriteResponse to the client
+func (o *AddCollectionBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(400)
+}
+
+// AddCollectionConflictCode is the HTTP code returned for type AddCollectionConflict
+const AddCollectionConflictCode int = 409
+
+/*AddCollectionConflict Collection already exists
+
+swagger:response addCollectionConflict
+*/
+type AddCollectionConflict struct {
+}
+
+// NewAddCollectionConflict creates AddCollectionConflict with default headers values
+func NewAddCollectionConflict() *AddCollectionConflict {
+
+	return &AddCollectionConflict{}
+}
+
+// WriteResponse to the client
+func (o *AddCollectionConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(409)
+}
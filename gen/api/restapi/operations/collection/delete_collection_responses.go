@@ -80,3 +80,27 @@ func (o *DeleteCollectionBadRequest) WriteResponse(rw http.ResponseWriter, produ
 
 	rw.WriteHeader(400)
 }
+
+// DeleteCollectionNotFoundCode is the HTTP code returned for type DeleteCollectionNotFound
+const DeleteCollectionNotFoundCode int = 404
+
+/*DeleteCollectionNotFound Collection not found
+
+swagger:response deleteCollectionNotFound
+*/
+type DeleteCollectionNotFound struct {
+}
+
+// NewDeleteCollectionNotFound creates DeleteCollectionNotFound with default headers values
+func NewDeleteCollectionNotFound() *DeleteCollectionNotFound {
+
+	return &DeleteCollectionNotFound{}
+}
+
+// WriteResponse to the client
+func (o *DeleteCollectionNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
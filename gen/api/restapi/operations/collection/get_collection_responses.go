@@ -80,3 +80,27 @@ func (o *GetCollectionBadRequest) WriteResponse(rw http.ResponseWriter, producer
 
 	rw.WriteHeader(400)
 }
+
+// GetCollectionNotFoundCode is the HTTP code returned for type GetCollectionNotFound
+const GetCollectionNotFoundCode int = 404
+
+/*GetCollectionNotFound Collection not found
+
+swagger:response getCollectionNotFound
+*/
+type GetCollectionNotFound struct {
+}
+
+// NewGetCollectionNotFound creates GetCollectionNotFound with default headers values
+func NewGetCollectionNotFound() *GetCollectionNotFound {
+
+	return &GetCollectionNotFound{}
+}
+
+// WriteResponse to the client
+func (o *GetCollectionNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/go-openapi/swag"
 
 	"Vectory/gen/api/restapi/operations/collection"
+	"Vectory/gen/api/restapi/operations/object"
 )
 
 // NewVectoryAPI creates a new Vectory instance
@@ -40,6 +41,7 @@ func NewVectoryAPI(spec *loads.Document) *VectoryAPI {
 		APIKeyAuthenticator: security.APIKeyAuth,
 		BearerAuthenticator: security.BearerAuth,
 
+		BinConsumer:  runtime.ByteStreamConsumer(),
 		JSONConsumer: runtime.JSONConsumer(),
 
 		JSONProducer: runtime.JSONProducer(),
@@ -47,12 +49,48 @@ func NewVectoryAPI(spec *loads.Document) *VectoryAPI {
 		CollectionAddCollectionHandler: collection.AddCollectionHandlerFunc(func(params collection.AddCollectionParams) middleware.Responder {
 			return middleware.NotImplemented("operation collection.AddCollection has not yet been implemented")
 		}),
+		CollectionBackupCollectionHandler: collection.BackupCollectionHandlerFunc(func(params collection.BackupCollectionParams) middleware.Responder {
+			return middleware.NotImplemented("operation collection.BackupCollection has not yet been implemented")
+		}),
 		CollectionDeleteCollectionHandler: collection.DeleteCollectionHandlerFunc(func(params collection.DeleteCollectionParams) middleware.Responder {
 			return middleware.NotImplemented("operation collection.DeleteCollection has not yet been implemented")
 		}),
 		CollectionGetCollectionHandler: collection.GetCollectionHandlerFunc(func(params collection.GetCollectionParams) middleware.Responder {
 			return middleware.NotImplemented("operation collection.GetCollection has not yet been implemented")
 		}),
+		CollectionRestoreCollectionHandler: collection.RestoreCollectionHandlerFunc(func(params collection.RestoreCollectionParams) middleware.Responder {
+			return middleware.NotImplemented("operation collection.RestoreCollection has not yet been implemented")
+		}),
+		ObjectDeleteObjectHandler: object.DeleteObjectHandlerFunc(func(params object.DeleteObjectParams) middleware.Responder {
+			return middleware.NotImplemented("operation object.DeleteObject has not yet been implemented")
+		}),
+		ObjectDeleteObjectByKeyHandler: object.DeleteObjectByKeyHandlerFunc(func(params object.DeleteObjectByKeyParams) middleware.Responder {
+			return middleware.NotImplemented("operation object.DeleteObjectByKey has not yet been implemented")
+		}),
+		ObjectGetObjectByKeyHandler: object.GetObjectByKeyHandlerFunc(func(params object.GetObjectByKeyParams) middleware.Responder {
+			return middleware.NotImplemented("operation object.GetObjectByKey has not yet been implemented")
+		}),
+		ObjectGetObjectsHandler: object.GetObjectsHandlerFunc(func(params object.GetObjectsParams) middleware.Responder {
+			return middleware.NotImplemented("operation object.GetObjects has not yet been implemented")
+		}),
+		ObjectImportObjectsHandler: object.ImportObjectsHandlerFunc(func(params object.ImportObjectsParams) middleware.Responder {
+			return middleware.NotImplemented("operation object.ImportObjects has not yet been implemented")
+		}),
+		ObjectInsertObjectHandler: object.InsertObjectHandlerFunc(func(params object.InsertObjectParams) middleware.Responder {
+			return middleware.NotImplemented("operation object.InsertObject has not yet been implemented")
+		}),
+		ObjectInsertObjectsBatchHandler: object.InsertObjectsBatchHandlerFunc(func(params object.InsertObjectsBatchParams) middleware.Responder {
+			return middleware.NotImplemented("operation object.InsertObjectsBatch has not yet been implemented")
+		}),
+		ObjectRangeSearchHandler: object.RangeSearchHandlerFunc(func(params object.RangeSearchParams) middleware.Responder {
+			return middleware.NotImplemented("operation object.RangeSearch has not yet been implemented")
+		}),
+		ObjectSemanticSearchHandler: object.SemanticSearchHandlerFunc(func(params object.SemanticSearchParams) middleware.Responder {
+			return middleware.NotImplemented("operation object.SemanticSearch has not yet been implemented")
+		}),
+		ObjectUpsertObjectHandler: object.UpsertObjectHandlerFunc(func(params object.UpsertObjectParams) middleware.Responder {
+			return middleware.NotImplemented("operation object.UpsertObject has not yet been implemented")
+		}),
 	}
 }
 
@@ -79,6 +117,10 @@ type VectoryAPI struct {
 	// It has a default implementation in the security package, however you can replace it for your particular usage.
 	BearerAuthenticator func(string, security.ScopedTokenAuthentication) runtime.Authenticator
 
+	// BinConsumer registers a consumer for the following mime types:
+	//   - application/octet-stream
+	BinConsumer runtime.Consumer
+
 	// JSONConsumer registers a consumer for the following mime types:
 	//   - application/json
 	JSONConsumer runtime.Consumer
@@ -89,10 +131,34 @@ type VectoryAPI struct {
 
 	// CollectionAddCollectionHandler sets the operation handler for the add collection operation
 	CollectionAddCollectionHandler collection.AddCollectionHandler
+	// CollectionBackupCollectionHandler sets the operation handler for the backup collection operation
+	CollectionBackupCollectionHandler collection.BackupCollectionHandler
 	// CollectionDeleteCollectionHandler sets the operation handler for the delete collection operation
 	CollectionDeleteCollectionHandler collection.DeleteCollectionHandler
 	// CollectionGetCollectionHandler sets the operation handler for the get collection operation
 	CollectionGetCollectionHandler collection.GetCollectionHandler
+	// CollectionRestoreCollectionHandler sets the operation handler for the restore collection operation
+	CollectionRestoreCollectionHandler collection.RestoreCollectionHandler
+	// ObjectDeleteObjectHandler sets the operation handler for the delete object operation
+	ObjectDeleteObjectHandler object.DeleteObjectHandler
+	// ObjectDeleteObjectByKeyHandler sets the operation handler for the delete object by key operation
+	ObjectDeleteObjectByKeyHandler object.DeleteObjectByKeyHandler
+	// ObjectGetObjectByKeyHandler sets the operation handler for the get object by key operation
+	ObjectGetObjectByKeyHandler object.GetObjectByKeyHandler
+	// ObjectGetObjectsHandler sets the operation handler for the get objects operation
+	ObjectGetObjectsHandler object.GetObjectsHandler
+	// ObjectImportObjectsHandler sets the operation handler for the import objects operation
+	ObjectImportObjectsHandler object.ImportObjectsHandler
+	// ObjectInsertObjectHandler sets the operation handler for the insert object operation
+	ObjectInsertObjectHandler object.InsertObjectHandler
+	// ObjectInsertObjectsBatchHandler sets the operation handler for the insert objects batch operation
+	ObjectInsertObjectsBatchHandler object.InsertObjectsBatchHandler
+	// ObjectRangeSearchHandler sets the operation handler for the range search operation
+	ObjectRangeSearchHandler object.RangeSearchHandler
+	// ObjectSemanticSearchHandler sets the operation handler for the semantic search operation
+	ObjectSemanticSearchHandler object.SemanticSearchHandler
+	// ObjectUpsertObjectHandler sets the operation handler for the upsert object operation
+	ObjectUpsertObjectHandler object.UpsertObjectHandler
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
 	ServeError func(http.ResponseWriter, *http.Request, error)
@@ -161,6 +227,10 @@ func (o *VectoryAPI) RegisterFormat(name string, format strfmt.Format, validator
 func (o *VectoryAPI) Validate() error {
 	var unregistered []string
 
+	if o.BinConsumer == nil {
+		unregistered = append(unregistered, "BinConsumer")
+	}
+
 	if o.JSONConsumer == nil {
 		unregistered = append(unregistered, "JSONConsumer")
 	}
@@ -172,12 +242,48 @@ func (o *VectoryAPI) Validate() error {
 	if o.CollectionAddCollectionHandler == nil {
 		unregistered = append(unregistered, "collection.AddCollectionHandler")
 	}
+	if o.CollectionBackupCollectionHandler == nil {
+		unregistered = append(unregistered, "collection.BackupCollectionHandler")
+	}
 	if o.CollectionDeleteCollectionHandler == nil {
 		unregistered = append(unregistered, "collection.DeleteCollectionHandler")
 	}
 	if o.CollectionGetCollectionHandler == nil {
 		unregistered = append(unregistered, "collection.GetCollectionHandler")
 	}
+	if o.CollectionRestoreCollectionHandler == nil {
+		unregistered = append(unregistered, "collection.RestoreCollectionHandler")
+	}
+	if o.ObjectDeleteObjectHandler == nil {
+		unregistered = append(unregistered, "object.DeleteObjectHandler")
+	}
+	if o.ObjectDeleteObjectByKeyHandler == nil {
+		unregistered = append(unregistered, "object.DeleteObjectByKeyHandler")
+	}
+	if o.ObjectGetObjectByKeyHandler == nil {
+		unregistered = append(unregistered, "object.GetObjectByKeyHandler")
+	}
+	if o.ObjectGetObjectsHandler == nil {
+		unregistered = append(unregistered, "object.GetObjectsHandler")
+	}
+	if o.ObjectImportObjectsHandler == nil {
+		unregistered = append(unregistered, "object.ImportObjectsHandler")
+	}
+	if o.ObjectInsertObjectHandler == nil {
+		unregistered = append(unregistered, "object.InsertObjectHandler")
+	}
+	if o.ObjectInsertObjectsBatchHandler == nil {
+		unregistered = append(unregistered, "object.InsertObjectsBatchHandler")
+	}
+	if o.ObjectRangeSearchHandler == nil {
+		unregistered = append(unregistered, "object.RangeSearchHandler")
+	}
+	if o.ObjectSemanticSearchHandler == nil {
+		unregistered = append(unregistered, "object.SemanticSearchHandler")
+	}
+	if o.ObjectUpsertObjectHandler == nil {
+		unregistered = append(unregistered, "object.UpsertObjectHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -209,6 +315,8 @@ func (o *VectoryAPI) ConsumersFor(mediaTypes []string) map[string]runtime.Consum
 		switch mt {
 		case "application/json":
 			result["application/json"] = o.JSONConsumer
+		case "application/octet-stream":
+			result["application/octet-stream"] = o.BinConsumer
 		}
 
 		if c, ok := o.customConsumers[mt]; ok {
@@ -270,6 +378,10 @@ func (o *VectoryAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/v1/collection"] = collection.NewAddCollection(o.context, o.CollectionAddCollectionHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v1/collection/{collectionName}/backup"] = collection.NewBackupCollection(o.context, o.CollectionBackupCollectionHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
@@ -278,6 +390,50 @@ func (o *VectoryAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/v1/collection/{collectionName}"] = collection.NewGetCollection(o.context, o.CollectionGetCollectionHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v1/backup/{backupName}/restore"] = collection.NewRestoreCollection(o.context, o.CollectionRestoreCollectionHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/v1/collection/{collectionName}/objects/{objectId}"] = object.NewDeleteObject(o.context, o.ObjectDeleteObjectHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/v1/collection/{collectionName}/objects/keys/{objectKey}"] = object.NewDeleteObjectByKey(o.context, o.ObjectDeleteObjectByKeyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v1/collection/{collectionName}/objects/keys/{objectKey}"] = object.NewGetObjectByKey(o.context, o.ObjectGetObjectByKeyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v1/collection/{collectionName}/objects"] = object.NewGetObjects(o.context, o.ObjectGetObjectsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v1/collection/{collectionName}/objects/import"] = object.NewImportObjects(o.context, o.ObjectImportObjectsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v1/collection/{collectionName}/objects"] = object.NewInsertObject(o.context, o.ObjectInsertObjectHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v1/collection/{collectionName}/objects/batch"] = object.NewInsertObjectsBatch(o.context, o.ObjectInsertObjectsBatchHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v1/collection/{collectionName}/range_search"] = object.NewRangeSearch(o.context, o.ObjectRangeSearchHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v1/collection/{collectionName}/search"] = object.NewSemanticSearch(o.context, o.ObjectSemanticSearchHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/v1/collection/{collectionName}/objects"] = object.NewUpsertObject(o.context, o.ObjectUpsertObjectHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
@@ -3,6 +3,8 @@
 package ent
 
 import (
+	"Vectory/entities/index"
+	"Vectory/entities/mappings"
 	"Vectory/gen/ent/collection"
 	"encoding/json"
 	"fmt"
@@ -30,7 +32,11 @@ type Collection struct {
 	// EmbedderConfig holds the value of the "embedder_config" field.
 	EmbedderConfig map[string]interface{} `json:"embedder_config,omitempty"`
 	// Mappings holds the value of the "mappings" field.
-	Mappings     []string `json:"mappings,omitempty"`
+	Mappings []mappings.Mapping `json:"mappings,omitempty"`
+	// VectorType holds the value of the "vector_type" field.
+	VectorType string `json:"vector_type,omitempty"`
+	// NamedVectors holds the value of the "named_vectors" field.
+	NamedVectors []index.NamedVector `json:"named_vectors,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -39,11 +45,11 @@ func (*Collection) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case collection.FieldIndexParams, collection.FieldEmbedderConfig, collection.FieldMappings:
+		case collection.FieldIndexParams, collection.FieldEmbedderConfig, collection.FieldMappings, collection.FieldNamedVectors:
 			values[i] = new([]byte)
 		case collection.FieldID:
 			values[i] = new(sql.NullInt64)
-		case collection.FieldName, collection.FieldIndexType, collection.FieldDataType, collection.FieldEmbedderType:
+		case collection.FieldName, collection.FieldIndexType, collection.FieldDataType, collection.FieldEmbedderType, collection.FieldVectorType:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -114,6 +120,20 @@ func (c *Collection) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field mappings: %w", err)
 				}
 			}
+		case collection.FieldVectorType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field vector_type", values[i])
+			} else if value.Valid {
+				c.VectorType = value.String
+			}
+		case collection.FieldNamedVectors:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field named_vectors", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.NamedVectors); err != nil {
+					return fmt.Errorf("unmarshal field named_vectors: %w", err)
+				}
+			}
 		default:
 			c.selectValues.Set(columns[i], values[i])
 		}
@@ -170,6 +190,12 @@ func (c *Collection) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("mappings=")
 	builder.WriteString(fmt.Sprintf("%v", c.Mappings))
+	builder.WriteString(", ")
+	builder.WriteString("vector_type=")
+	builder.WriteString(c.VectorType)
+	builder.WriteString(", ")
+	builder.WriteString("named_vectors=")
+	builder.WriteString(fmt.Sprintf("%v", c.NamedVectors))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -25,6 +25,10 @@ const (
 	FieldEmbedderConfig = "embedder_config"
 	// FieldMappings holds the string denoting the mappings field in the database.
 	FieldMappings = "mappings"
+	// FieldVectorType holds the string denoting the vector_type field in the database.
+	FieldVectorType = "vector_type"
+	// FieldNamedVectors holds the string denoting the named_vectors field in the database.
+	FieldNamedVectors = "named_vectors"
 	// Table holds the table name of the collection in the database.
 	Table = "collections"
 )
@@ -39,6 +43,8 @@ var Columns = []string{
 	FieldIndexParams,
 	FieldEmbedderConfig,
 	FieldMappings,
+	FieldVectorType,
+	FieldNamedVectors,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -51,6 +57,11 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+var (
+	// DefaultVectorType holds the default value on creation for the "vector_type" field.
+	DefaultVectorType string
+)
+
 // OrderOption defines the ordering options for the Collection queries.
 type OrderOption func(*sql.Selector)
 
@@ -78,3 +89,8 @@ func ByDataType(opts ...sql.OrderTermOption) OrderOption {
 func ByEmbedderType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEmbedderType, opts...).ToFunc()
 }
+
+// ByVectorType orders the results by the vector_type field.
+func ByVectorType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVectorType, opts...).ToFunc()
+}
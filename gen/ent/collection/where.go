@@ -73,6 +73,11 @@ func EmbedderType(v string) predicate.Collection {
 	return predicate.Collection(sql.FieldEQ(FieldEmbedderType, v))
 }
 
+// VectorType applies equality check predicate on the "vector_type" field. It's identical to VectorTypeEQ.
+func VectorType(v string) predicate.Collection {
+	return predicate.Collection(sql.FieldEQ(FieldVectorType, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Collection {
 	return predicate.Collection(sql.FieldEQ(FieldName, v))
@@ -333,6 +338,81 @@ func EmbedderTypeContainsFold(v string) predicate.Collection {
 	return predicate.Collection(sql.FieldContainsFold(FieldEmbedderType, v))
 }
 
+// VectorTypeEQ applies the EQ predicate on the "vector_type" field.
+func VectorTypeEQ(v string) predicate.Collection {
+	return predicate.Collection(sql.FieldEQ(FieldVectorType, v))
+}
+
+// VectorTypeNEQ applies the NEQ predicate on the "vector_type" field.
+func VectorTypeNEQ(v string) predicate.Collection {
+	return predicate.Collection(sql.FieldNEQ(FieldVectorType, v))
+}
+
+// VectorTypeIn applies the In predicate on the "vector_type" field.
+func VectorTypeIn(vs ...string) predicate.Collection {
+	return predicate.Collection(sql.FieldIn(FieldVectorType, vs...))
+}
+
+// VectorTypeNotIn applies the NotIn predicate on the "vector_type" field.
+func VectorTypeNotIn(vs ...string) predicate.Collection {
+	return predicate.Collection(sql.FieldNotIn(FieldVectorType, vs...))
+}
+
+// VectorTypeGT applies the GT predicate on the "vector_type" field.
+func VectorTypeGT(v string) predicate.Collection {
+	return predicate.Collection(sql.FieldGT(FieldVectorType, v))
+}
+
+// VectorTypeGTE applies the GTE predicate on the "vector_type" field.
+func VectorTypeGTE(v string) predicate.Collection {
+	return predicate.Collection(sql.FieldGTE(FieldVectorType, v))
+}
+
+// VectorTypeLT applies the LT predicate on the "vector_type" field.
+func VectorTypeLT(v string) predicate.Collection {
+	return predicate.Collection(sql.FieldLT(FieldVectorType, v))
+}
+
+// VectorTypeLTE applies the LTE predicate on the "vector_type" field.
+func VectorTypeLTE(v string) predicate.Collection {
+	return predicate.Collection(sql.FieldLTE(FieldVectorType, v))
+}
+
+// VectorTypeContains applies the Contains predicate on the "vector_type" field.
+func VectorTypeContains(v string) predicate.Collection {
+	return predicate.Collection(sql.FieldContains(FieldVectorType, v))
+}
+
+// VectorTypeHasPrefix applies the HasPrefix predicate on the "vector_type" field.
+func VectorTypeHasPrefix(v string) predicate.Collection {
+	return predicate.Collection(sql.FieldHasPrefix(FieldVectorType, v))
+}
+
+// VectorTypeHasSuffix applies the HasSuffix predicate on the "vector_type" field.
+func VectorTypeHasSuffix(v string) predicate.Collection {
+	return predicate.Collection(sql.FieldHasSuffix(FieldVectorType, v))
+}
+
+// VectorTypeEqualFold applies the EqualFold predicate on the "vector_type" field.
+func VectorTypeEqualFold(v string) predicate.Collection {
+	return predicate.Collection(sql.FieldEqualFold(FieldVectorType, v))
+}
+
+// VectorTypeContainsFold applies the ContainsFold predicate on the "vector_type" field.
+func VectorTypeContainsFold(v string) predicate.Collection {
+	return predicate.Collection(sql.FieldContainsFold(FieldVectorType, v))
+}
+
+// NamedVectorsIsNil applies the IsNil predicate on the "named_vectors" field.
+func NamedVectorsIsNil() predicate.Collection {
+	return predicate.Collection(sql.FieldIsNull(FieldNamedVectors))
+}
+
+// NamedVectorsNotNil applies the NotNil predicate on the "named_vectors" field.
+func NamedVectorsNotNil() predicate.Collection {
+	return predicate.Collection(sql.FieldNotNull(FieldNamedVectors))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Collection) predicate.Collection {
 	return predicate.Collection(func(s *sql.Selector) {
@@ -3,6 +3,8 @@
 package ent
 
 import (
+	"Vectory/entities/index"
+	"Vectory/entities/mappings"
 	"Vectory/gen/ent/collection"
 	"context"
 	"errors"
@@ -56,11 +58,31 @@ func (cc *CollectionCreate) SetEmbedderConfig(m map[string]interface{}) *Collect
 }
 
 // SetMappings sets the "mappings" field.
-func (cc *CollectionCreate) SetMappings(s []string) *CollectionCreate {
+func (cc *CollectionCreate) SetMappings(s []mappings.Mapping) *CollectionCreate {
 	cc.mutation.SetMappings(s)
 	return cc
 }
 
+// SetVectorType sets the "vector_type" field.
+func (cc *CollectionCreate) SetVectorType(s string) *CollectionCreate {
+	cc.mutation.SetVectorType(s)
+	return cc
+}
+
+// SetNillableVectorType sets the "vector_type" field if the given value is not nil.
+func (cc *CollectionCreate) SetNillableVectorType(s *string) *CollectionCreate {
+	if s != nil {
+		cc.SetVectorType(*s)
+	}
+	return cc
+}
+
+// SetNamedVectors sets the "named_vectors" field.
+func (cc *CollectionCreate) SetNamedVectors(iv []index.NamedVector) *CollectionCreate {
+	cc.mutation.SetNamedVectors(iv)
+	return cc
+}
+
 // Mutation returns the CollectionMutation object of the builder.
 func (cc *CollectionCreate) Mutation() *CollectionMutation {
 	return cc.mutation
@@ -68,6 +90,7 @@ func (cc *CollectionCreate) Mutation() *CollectionMutation {
 
 // Save creates the Collection in the database.
 func (cc *CollectionCreate) Save(ctx context.Context) (*Collection, error) {
+	cc.defaults()
 	return withHooks(ctx, cc.sqlSave, cc.mutation, cc.hooks)
 }
 
@@ -93,6 +116,14 @@ func (cc *CollectionCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (cc *CollectionCreate) defaults() {
+	if _, ok := cc.mutation.VectorType(); !ok {
+		v := collection.DefaultVectorType
+		cc.mutation.SetVectorType(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (cc *CollectionCreate) check() error {
 	if _, ok := cc.mutation.Name(); !ok {
@@ -116,6 +147,9 @@ func (cc *CollectionCreate) check() error {
 	if _, ok := cc.mutation.Mappings(); !ok {
 		return &ValidationError{Name: "mappings", err: errors.New(`ent: missing required field "Collection.mappings"`)}
 	}
+	if _, ok := cc.mutation.VectorType(); !ok {
+		return &ValidationError{Name: "vector_type", err: errors.New(`ent: missing required field "Collection.vector_type"`)}
+	}
 	return nil
 }
 
@@ -170,6 +204,14 @@ func (cc *CollectionCreate) createSpec() (*Collection, *sqlgraph.CreateSpec) {
 		_spec.SetField(collection.FieldMappings, field.TypeJSON, value)
 		_node.Mappings = value
 	}
+	if value, ok := cc.mutation.VectorType(); ok {
+		_spec.SetField(collection.FieldVectorType, field.TypeString, value)
+		_node.VectorType = value
+	}
+	if value, ok := cc.mutation.NamedVectors(); ok {
+		_spec.SetField(collection.FieldNamedVectors, field.TypeJSON, value)
+		_node.NamedVectors = value
+	}
 	return _node, _spec
 }
 
@@ -187,6 +229,7 @@ func (ccb *CollectionCreateBulk) Save(ctx context.Context) ([]*Collection, error
 	for i := range ccb.builders {
 		func(i int, root context.Context) {
 			builder := ccb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*CollectionMutation)
 				if !ok {
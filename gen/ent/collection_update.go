@@ -3,6 +3,8 @@
 package ent
 
 import (
+	"Vectory/entities/index"
+	"Vectory/entities/mappings"
 	"Vectory/gen/ent/collection"
 	"Vectory/gen/ent/predicate"
 	"context"
@@ -65,17 +67,49 @@ func (cu *CollectionUpdate) SetEmbedderConfig(m map[string]interface{}) *Collect
 }
 
 // SetMappings sets the "mappings" field.
-func (cu *CollectionUpdate) SetMappings(s []string) *CollectionUpdate {
+func (cu *CollectionUpdate) SetMappings(s []mappings.Mapping) *CollectionUpdate {
 	cu.mutation.SetMappings(s)
 	return cu
 }
 
 // AppendMappings appends s to the "mappings" field.
-func (cu *CollectionUpdate) AppendMappings(s []string) *CollectionUpdate {
+func (cu *CollectionUpdate) AppendMappings(s []mappings.Mapping) *CollectionUpdate {
 	cu.mutation.AppendMappings(s)
 	return cu
 }
 
+// SetVectorType sets the "vector_type" field.
+func (cu *CollectionUpdate) SetVectorType(s string) *CollectionUpdate {
+	cu.mutation.SetVectorType(s)
+	return cu
+}
+
+// SetNillableVectorType sets the "vector_type" field if the given value is not nil.
+func (cu *CollectionUpdate) SetNillableVectorType(s *string) *CollectionUpdate {
+	if s != nil {
+		cu.SetVectorType(*s)
+	}
+	return cu
+}
+
+// SetNamedVectors sets the "named_vectors" field.
+func (cu *CollectionUpdate) SetNamedVectors(iv []index.NamedVector) *CollectionUpdate {
+	cu.mutation.SetNamedVectors(iv)
+	return cu
+}
+
+// AppendNamedVectors appends iv to the "named_vectors" field.
+func (cu *CollectionUpdate) AppendNamedVectors(iv []index.NamedVector) *CollectionUpdate {
+	cu.mutation.AppendNamedVectors(iv)
+	return cu
+}
+
+// ClearNamedVectors clears the value of the "named_vectors" field.
+func (cu *CollectionUpdate) ClearNamedVectors() *CollectionUpdate {
+	cu.mutation.ClearNamedVectors()
+	return cu
+}
+
 // Mutation returns the CollectionMutation object of the builder.
 func (cu *CollectionUpdate) Mutation() *CollectionMutation {
 	return cu.mutation
@@ -143,6 +177,20 @@ func (cu *CollectionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			sqljson.Append(u, collection.FieldMappings, value)
 		})
 	}
+	if value, ok := cu.mutation.VectorType(); ok {
+		_spec.SetField(collection.FieldVectorType, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.NamedVectors(); ok {
+		_spec.SetField(collection.FieldNamedVectors, field.TypeJSON, value)
+	}
+	if value, ok := cu.mutation.AppendedNamedVectors(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, collection.FieldNamedVectors, value)
+		})
+	}
+	if cu.mutation.NamedVectorsCleared() {
+		_spec.ClearField(collection.FieldNamedVectors, field.TypeJSON)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{collection.Label}
@@ -200,17 +248,49 @@ func (cuo *CollectionUpdateOne) SetEmbedderConfig(m map[string]interface{}) *Col
 }
 
 // SetMappings sets the "mappings" field.
-func (cuo *CollectionUpdateOne) SetMappings(s []string) *CollectionUpdateOne {
+func (cuo *CollectionUpdateOne) SetMappings(s []mappings.Mapping) *CollectionUpdateOne {
 	cuo.mutation.SetMappings(s)
 	return cuo
 }
 
 // AppendMappings appends s to the "mappings" field.
-func (cuo *CollectionUpdateOne) AppendMappings(s []string) *CollectionUpdateOne {
+func (cuo *CollectionUpdateOne) AppendMappings(s []mappings.Mapping) *CollectionUpdateOne {
 	cuo.mutation.AppendMappings(s)
 	return cuo
 }
 
+// SetVectorType sets the "vector_type" field.
+func (cuo *CollectionUpdateOne) SetVectorType(s string) *CollectionUpdateOne {
+	cuo.mutation.SetVectorType(s)
+	return cuo
+}
+
+// SetNillableVectorType sets the "vector_type" field if the given value is not nil.
+func (cuo *CollectionUpdateOne) SetNillableVectorType(s *string) *CollectionUpdateOne {
+	if s != nil {
+		cuo.SetVectorType(*s)
+	}
+	return cuo
+}
+
+// SetNamedVectors sets the "named_vectors" field.
+func (cuo *CollectionUpdateOne) SetNamedVectors(iv []index.NamedVector) *CollectionUpdateOne {
+	cuo.mutation.SetNamedVectors(iv)
+	return cuo
+}
+
+// AppendNamedVectors appends iv to the "named_vectors" field.
+func (cuo *CollectionUpdateOne) AppendNamedVectors(iv []index.NamedVector) *CollectionUpdateOne {
+	cuo.mutation.AppendNamedVectors(iv)
+	return cuo
+}
+
+// ClearNamedVectors clears the value of the "named_vectors" field.
+func (cuo *CollectionUpdateOne) ClearNamedVectors() *CollectionUpdateOne {
+	cuo.mutation.ClearNamedVectors()
+	return cuo
+}
+
 // Mutation returns the CollectionMutation object of the builder.
 func (cuo *CollectionUpdateOne) Mutation() *CollectionMutation {
 	return cuo.mutation
@@ -308,6 +388,20 @@ func (cuo *CollectionUpdateOne) sqlSave(ctx context.Context) (_node *Collection,
 			sqljson.Append(u, collection.FieldMappings, value)
 		})
 	}
+	if value, ok := cuo.mutation.VectorType(); ok {
+		_spec.SetField(collection.FieldVectorType, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.NamedVectors(); ok {
+		_spec.SetField(collection.FieldNamedVectors, field.TypeJSON, value)
+	}
+	if value, ok := cuo.mutation.AppendedNamedVectors(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, collection.FieldNamedVectors, value)
+		})
+	}
+	if cuo.mutation.NamedVectorsCleared() {
+		_spec.ClearField(collection.FieldNamedVectors, field.TypeJSON)
+	}
 	_node = &Collection{config: cuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
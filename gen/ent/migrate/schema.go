@@ -18,6 +18,8 @@ var (
 		{Name: "index_params", Type: field.TypeJSON},
 		{Name: "embedder_config", Type: field.TypeJSON},
 		{Name: "mappings", Type: field.TypeJSON},
+		{Name: "vector_type", Type: field.TypeString, Default: "float32"},
+		{Name: "named_vectors", Type: field.TypeJSON, Nullable: true},
 	}
 	// CollectionsTable holds the schema information for the "collections" table.
 	CollectionsTable = &schema.Table{
@@ -3,6 +3,8 @@
 package ent
 
 import (
+	"Vectory/entities/index"
+	"Vectory/entities/mappings"
 	"Vectory/gen/ent/collection"
 	"Vectory/gen/ent/predicate"
 	"context"
@@ -29,21 +31,24 @@ const (
 // CollectionMutation represents an operation that mutates the Collection nodes in the graph.
 type CollectionMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	name            *string
-	index_type      *string
-	data_type       *string
-	embedder_type   *string
-	index_params    *map[string]interface{}
-	embedder_config *map[string]interface{}
-	mappings        *[]string
-	appendmappings  []string
-	clearedFields   map[string]struct{}
-	done            bool
-	oldValue        func(context.Context) (*Collection, error)
-	predicates      []predicate.Collection
+	op                  Op
+	typ                 string
+	id                  *int
+	name                *string
+	index_type          *string
+	data_type           *string
+	embedder_type       *string
+	index_params        *map[string]interface{}
+	embedder_config     *map[string]interface{}
+	mappings            *[]mappings.Mapping
+	appendmappings      []mappings.Mapping
+	vector_type         *string
+	named_vectors       *[]index.NamedVector
+	appendnamed_vectors []index.NamedVector
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*Collection, error)
+	predicates          []predicate.Collection
 }
 
 var _ ent.Mutation = (*CollectionMutation)(nil)
@@ -361,13 +366,13 @@ func (m *CollectionMutation) ResetEmbedderConfig() {
 }
 
 // SetMappings sets the "mappings" field.
-func (m *CollectionMutation) SetMappings(s []string) {
+func (m *CollectionMutation) SetMappings(s []mappings.Mapping) {
 	m.mappings = &s
 	m.appendmappings = nil
 }
 
 // Mappings returns the value of the "mappings" field in the mutation.
-func (m *CollectionMutation) Mappings() (r []string, exists bool) {
+func (m *CollectionMutation) Mappings() (r []mappings.Mapping, exists bool) {
 	v := m.mappings
 	if v == nil {
 		return
@@ -378,7 +383,7 @@ func (m *CollectionMutation) Mappings() (r []string, exists bool) {
 // OldMappings returns the old "mappings" field's value of the Collection entity.
 // If the Collection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CollectionMutation) OldMappings(ctx context.Context) (v []string, err error) {
+func (m *CollectionMutation) OldMappings(ctx context.Context) (v []mappings.Mapping, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldMappings is only allowed on UpdateOne operations")
 	}
@@ -393,12 +398,12 @@ func (m *CollectionMutation) OldMappings(ctx context.Context) (v []string, err e
 }
 
 // AppendMappings adds s to the "mappings" field.
-func (m *CollectionMutation) AppendMappings(s []string) {
+func (m *CollectionMutation) AppendMappings(s []mappings.Mapping) {
 	m.appendmappings = append(m.appendmappings, s...)
 }
 
 // AppendedMappings returns the list of values that were appended to the "mappings" field in this mutation.
-func (m *CollectionMutation) AppendedMappings() ([]string, bool) {
+func (m *CollectionMutation) AppendedMappings() ([]mappings.Mapping, bool) {
 	if len(m.appendmappings) == 0 {
 		return nil, false
 	}
@@ -411,6 +416,107 @@ func (m *CollectionMutation) ResetMappings() {
 	m.appendmappings = nil
 }
 
+// SetVectorType sets the "vector_type" field.
+func (m *CollectionMutation) SetVectorType(s string) {
+	m.vector_type = &s
+}
+
+// VectorType returns the value of the "vector_type" field in the mutation.
+func (m *CollectionMutation) VectorType() (r string, exists bool) {
+	v := m.vector_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVectorType returns the old "vector_type" field's value of the Collection entity.
+// If the Collection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CollectionMutation) OldVectorType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVectorType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVectorType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVectorType: %w", err)
+	}
+	return oldValue.VectorType, nil
+}
+
+// ResetVectorType resets all changes to the "vector_type" field.
+func (m *CollectionMutation) ResetVectorType() {
+	m.vector_type = nil
+}
+
+// SetNamedVectors sets the "named_vectors" field.
+func (m *CollectionMutation) SetNamedVectors(iv []index.NamedVector) {
+	m.named_vectors = &iv
+	m.appendnamed_vectors = nil
+}
+
+// NamedVectors returns the value of the "named_vectors" field in the mutation.
+func (m *CollectionMutation) NamedVectors() (r []index.NamedVector, exists bool) {
+	v := m.named_vectors
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamedVectors returns the old "named_vectors" field's value of the Collection entity.
+// If the Collection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CollectionMutation) OldNamedVectors(ctx context.Context) (v []index.NamedVector, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamedVectors is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamedVectors requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamedVectors: %w", err)
+	}
+	return oldValue.NamedVectors, nil
+}
+
+// AppendNamedVectors adds iv to the "named_vectors" field.
+func (m *CollectionMutation) AppendNamedVectors(iv []index.NamedVector) {
+	m.appendnamed_vectors = append(m.appendnamed_vectors, iv...)
+}
+
+// AppendedNamedVectors returns the list of values that were appended to the "named_vectors" field in this mutation.
+func (m *CollectionMutation) AppendedNamedVectors() ([]index.NamedVector, bool) {
+	if len(m.appendnamed_vectors) == 0 {
+		return nil, false
+	}
+	return m.appendnamed_vectors, true
+}
+
+// ClearNamedVectors clears the value of the "named_vectors" field.
+func (m *CollectionMutation) ClearNamedVectors() {
+	m.named_vectors = nil
+	m.appendnamed_vectors = nil
+	m.clearedFields[collection.FieldNamedVectors] = struct{}{}
+}
+
+// NamedVectorsCleared returns if the "named_vectors" field was cleared in this mutation.
+func (m *CollectionMutation) NamedVectorsCleared() bool {
+	_, ok := m.clearedFields[collection.FieldNamedVectors]
+	return ok
+}
+
+// ResetNamedVectors resets all changes to the "named_vectors" field.
+func (m *CollectionMutation) ResetNamedVectors() {
+	m.named_vectors = nil
+	m.appendnamed_vectors = nil
+	delete(m.clearedFields, collection.FieldNamedVectors)
+}
+
 // Where appends a list predicates to the CollectionMutation builder.
 func (m *CollectionMutation) Where(ps ...predicate.Collection) {
 	m.predicates = append(m.predicates, ps...)
@@ -445,7 +551,7 @@ func (m *CollectionMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *CollectionMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+	fields := make([]string, 0, 9)
 	if m.name != nil {
 		fields = append(fields, collection.FieldName)
 	}
@@ -467,6 +573,12 @@ func (m *CollectionMutation) Fields() []string {
 	if m.mappings != nil {
 		fields = append(fields, collection.FieldMappings)
 	}
+	if m.vector_type != nil {
+		fields = append(fields, collection.FieldVectorType)
+	}
+	if m.named_vectors != nil {
+		fields = append(fields, collection.FieldNamedVectors)
+	}
 	return fields
 }
 
@@ -489,6 +601,10 @@ func (m *CollectionMutation) Field(name string) (ent.Value, bool) {
 		return m.EmbedderConfig()
 	case collection.FieldMappings:
 		return m.Mappings()
+	case collection.FieldVectorType:
+		return m.VectorType()
+	case collection.FieldNamedVectors:
+		return m.NamedVectors()
 	}
 	return nil, false
 }
@@ -512,6 +628,10 @@ func (m *CollectionMutation) OldField(ctx context.Context, name string) (ent.Val
 		return m.OldEmbedderConfig(ctx)
 	case collection.FieldMappings:
 		return m.OldMappings(ctx)
+	case collection.FieldVectorType:
+		return m.OldVectorType(ctx)
+	case collection.FieldNamedVectors:
+		return m.OldNamedVectors(ctx)
 	}
 	return nil, fmt.Errorf("unknown Collection field %s", name)
 }
@@ -564,12 +684,26 @@ func (m *CollectionMutation) SetField(name string, value ent.Value) error {
 		m.SetEmbedderConfig(v)
 		return nil
 	case collection.FieldMappings:
-		v, ok := value.([]string)
+		v, ok := value.([]mappings.Mapping)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetMappings(v)
 		return nil
+	case collection.FieldVectorType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVectorType(v)
+		return nil
+	case collection.FieldNamedVectors:
+		v, ok := value.([]index.NamedVector)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamedVectors(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Collection field %s", name)
 }
@@ -599,7 +733,11 @@ func (m *CollectionMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *CollectionMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(collection.FieldNamedVectors) {
+		fields = append(fields, collection.FieldNamedVectors)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -612,6 +750,11 @@ func (m *CollectionMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *CollectionMutation) ClearField(name string) error {
+	switch name {
+	case collection.FieldNamedVectors:
+		m.ClearNamedVectors()
+		return nil
+	}
 	return fmt.Errorf("unknown Collection nullable field %s", name)
 }
 
@@ -640,6 +783,12 @@ func (m *CollectionMutation) ResetField(name string) error {
 	case collection.FieldMappings:
 		m.ResetMappings()
 		return nil
+	case collection.FieldVectorType:
+		m.ResetVectorType()
+		return nil
+	case collection.FieldNamedVectors:
+		m.ResetNamedVectors()
+		return nil
 	}
 	return fmt.Errorf("unknown Collection field %s", name)
 }
@@ -2,8 +2,19 @@
 
 package ent
 
+import (
+	"Vectory/db/metadata/schema"
+	"Vectory/gen/ent/collection"
+)
+
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	collectionFields := schema.Collection{}.Fields()
+	_ = collectionFields
+	// collectionDescVectorType is the schema descriptor for vector_type field.
+	collectionDescVectorType := collectionFields[7].Descriptor()
+	// collection.DefaultVectorType holds the default value on creation for the vector_type field.
+	collection.DefaultVectorType = collectionDescVectorType.Default.(string)
 }
@@ -29,6 +29,18 @@ func (o *AddCollectionReader) ReadResponse(response runtime.ClientResponse, cons
 			return nil, err
 		}
 		return result, nil
+	case 400:
+		result := NewAddCollectionBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewAddCollectionConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 
 	default:
 		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
@@ -67,3 +79,45 @@ func (o *AddCollectionCreated) readResponse(response runtime.ClientResponse, con
 
 	return nil
 }
+
+// NewAddCollectionBadRequest creates a AddCollectionBadRequest with default headers values
+func NewAddCollectionBadRequest() *AddCollectionBadRequest {
+	return &AddCollectionBadRequest{}
+}
+
+/*AddCollectionBadRequest handles this case with default header values.
+
+Invalid collection
+*/
+type AddCollectionBadRequest struct {
+}
+
+func (o *AddCollectionBadRequest) Error() string {
+	return fmt.Sprintf("[POST /v1/collection][%d] addCollectionBadRequest ", 400)
+}
+
+func (o *AddCollectionBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAddCollectionConflict creates a AddCollectionConflict with default headers values
+func NewAddCollectionConflict() *AddCollectionConflict {
+	return &AddCollectionConflict{}
+}
+
+/*AddCollectionConflict handles this case with default header values.
+
+Collection already exists
+*/
+type AddCollectionConflict struct {
+}
+
+func (o *AddCollectionConflict) Error() string {
+	return fmt.Sprintf("[POST /v1/collection][%d] addCollectionConflict ", 409)
+}
+
+func (o *AddCollectionConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
@@ -29,10 +29,14 @@ type Client struct {
 type ClientService interface {
 	AddCollection(params *AddCollectionParams) (*AddCollectionCreated, error)
 
+	BackupCollection(params *BackupCollectionParams) (*BackupCollectionCreated, error)
+
 	DeleteCollection(params *DeleteCollectionParams) (*DeleteCollectionOK, error)
 
 	GetCollection(params *GetCollectionParams) (*GetCollectionOK, error)
 
+	RestoreCollection(params *RestoreCollectionParams) (*RestoreCollectionCreated, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -72,6 +76,42 @@ func (a *Client) AddCollection(params *AddCollectionParams) (*AddCollectionCreat
 	panic(msg)
 }
 
+/*
+  BackupCollection backs up a collection
+
+  Write an archive of the collection to the server's backups directory, the collection stays online while it's written
+*/
+func (a *Client) BackupCollection(params *BackupCollectionParams) (*BackupCollectionCreated, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBackupCollectionParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "backupCollection",
+		Method:             "POST",
+		PathPattern:        "/v1/collection/{collectionName}/backup",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &BackupCollectionReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BackupCollectionCreated)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for backupCollection: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   DeleteCollection deletes a collection from the database
 
@@ -144,6 +184,42 @@ func (a *Client) GetCollection(params *GetCollectionParams) (*GetCollectionOK, e
 	panic(msg)
 }
 
+/*
+  RestoreCollection restores a collection from a backup
+
+  Create the collection of a backup in the server's backups directory, the collection must not exist
+*/
+func (a *Client) RestoreCollection(params *RestoreCollectionParams) (*RestoreCollectionCreated, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRestoreCollectionParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "restoreCollection",
+		Method:             "POST",
+		PathPattern:        "/v1/backup/{backupName}/restore",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &RestoreCollectionReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RestoreCollectionCreated)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for restoreCollection: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
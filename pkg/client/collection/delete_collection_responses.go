@@ -35,6 +35,12 @@ func (o *DeleteCollectionReader) ReadResponse(response runtime.ClientResponse, c
 			return nil, err
 		}
 		return nil, result
+	case 404:
+		result := NewDeleteCollectionNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 
 	default:
 		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
@@ -94,3 +100,24 @@ func (o *DeleteCollectionBadRequest) readResponse(response runtime.ClientRespons
 
 	return nil
 }
+
+// NewDeleteCollectionNotFound creates a DeleteCollectionNotFound with default headers values
+func NewDeleteCollectionNotFound() *DeleteCollectionNotFound {
+	return &DeleteCollectionNotFound{}
+}
+
+/*DeleteCollectionNotFound handles this case with default header values.
+
+Collection not found
+*/
+type DeleteCollectionNotFound struct {
+}
+
+func (o *DeleteCollectionNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /v1/collection/{collectionName}][%d] deleteCollectionNotFound ", 404)
+}
+
+func (o *DeleteCollectionNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
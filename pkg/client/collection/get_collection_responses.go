@@ -35,6 +35,12 @@ func (o *GetCollectionReader) ReadResponse(response runtime.ClientResponse, cons
 			return nil, err
 		}
 		return nil, result
+	case 404:
+		result := NewGetCollectionNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 
 	default:
 		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
@@ -94,3 +100,24 @@ func (o *GetCollectionBadRequest) readResponse(response runtime.ClientResponse,
 
 	return nil
 }
+
+// NewGetCollectionNotFound creates a GetCollectionNotFound with default headers values
+func NewGetCollectionNotFound() *GetCollectionNotFound {
+	return &GetCollectionNotFound{}
+}
+
+/*GetCollectionNotFound handles this case with default header values.
+
+Collection not found
+*/
+type GetCollectionNotFound struct {
+}
+
+func (o *GetCollectionNotFound) Error() string {
+	return fmt.Sprintf("[GET /v1/collection/{collectionName}][%d] getCollectionNotFound ", 404)
+}
+
+func (o *GetCollectionNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/go-openapi/strfmt"
 
 	"Vectory/pkg/client/collection"
+	"Vectory/pkg/client/object"
 )
 
 // Default vectory HTTP client.
@@ -56,6 +57,7 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) *Vectory {
 	cli := new(Vectory)
 	cli.Transport = transport
 	cli.Collection = collection.New(transport, formats)
+	cli.Object = object.New(transport, formats)
 	return cli
 }
 
@@ -102,6 +104,8 @@ func (cfg *TransportConfig) WithSchemes(schemes []string) *TransportConfig {
 type Vectory struct {
 	Collection collection.ClientService
 
+	Object object.ClientService
+
 	Transport runtime.ClientTransport
 }
 
@@ -109,4 +113,5 @@ type Vectory struct {
 func (c *Vectory) SetTransport(transport runtime.ClientTransport) {
 	c.Transport = transport
 	c.Collection.SetTransport(transport)
+	c.Object.SetTransport(transport)
 }